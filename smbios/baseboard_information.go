@@ -4,8 +4,6 @@
 
 package smbios
 
-import "github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
-
 // BaseboardInformation represents the SMBIOS baseboard information.
 type BaseboardInformation struct {
 	// Manufacturer returns the baseboard manufacturer.
@@ -28,10 +26,13 @@ type BaseboardInformation struct {
 	LocationInChassis string
 	// BoardType identifies the type of board. See 7.3.2.
 	BoardType BoardType
+
+	// FieldPresence returns the fields that the structure was too short to contain.
+	FieldPresence
 }
 
 // NewBaseboardInformation initializes and returns a new `BaseboardInformation`.
-func NewBaseboardInformation(s *smbios.Structure) *BaseboardInformation {
+func NewBaseboardInformation(s *Structure) *BaseboardInformation {
 	return &BaseboardInformation{
 		Manufacturer:      GetStringOrEmpty(s, 0x04),
 		Product:           GetStringOrEmpty(s, 0x05),
@@ -40,9 +41,15 @@ func NewBaseboardInformation(s *smbios.Structure) *BaseboardInformation {
 		AssetTag:          GetStringOrEmpty(s, 0x08),
 		LocationInChassis: GetStringOrEmpty(s, 0x0A),
 		BoardType:         BoardType(GetByte(s, 0x0D)),
+		FieldPresence:     _GetFieldPresence(s),
 	}
 }
 
+// MarshalJSON implements `json.Marshaler`, encoding absent fields as null.
+func (b BaseboardInformation) MarshalJSON() ([]byte, error) {
+	return _MarshalJSONWithPresence(b, b.FieldPresence)
+}
+
 // BoardType defines the board type enum.
 type BoardType int
 
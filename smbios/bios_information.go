@@ -4,8 +4,6 @@
 
 package smbios
 
-import "github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
-
 // BIOSInformation represents the BIOS information.
 type BIOSInformation struct {
 	// Vendor returns the BIOS vendor.
@@ -17,7 +15,7 @@ type BIOSInformation struct {
 }
 
 // NewBIOSInformation initializes and returns a new `BIOSInformation`.
-func NewBIOSInformation(s *smbios.Structure) *BIOSInformation {
+func NewBIOSInformation(s *Structure) *BIOSInformation {
 	return &BIOSInformation{
 		GetStringOrEmpty(s, 0x04),
 		GetStringOrEmpty(s, 0x05),
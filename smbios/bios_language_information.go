@@ -4,10 +4,14 @@
 
 package smbios
 
-import "github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
+import "strings"
 
 // BIOSLanguageInformation represents the SMBIOS BIOS language information.
 type BIOSLanguageInformation struct {
+	// NumberOfInstallableLanguages returns the number of languages available.
+	NumberOfInstallableLanguages uint8
+	// Flags returns the BIOS language flags.
+	Flags BIOSLanguageFlags
 	// CurrentLanguage returns the current language.
 	CurrentLanguage string
 	// InstallableLanguages returns the installable languages.
@@ -15,9 +19,95 @@ type BIOSLanguageInformation struct {
 }
 
 // NewBIOSLanguageInformation initializes and returns a new `BIOSLanguageInformation`.
-func NewBIOSLanguageInformation(s *smbios.Structure) *BIOSLanguageInformation {
-	return &BIOSLanguageInformation{
-		CurrentLanguage:      GetStringOrEmpty(s, 0x15),
-		InstallableLanguages: GetStrings(s),
+func NewBIOSLanguageInformation(s *Structure) *BIOSLanguageInformation {
+	b := &BIOSLanguageInformation{
+		NumberOfInstallableLanguages: GetByte(s, 0x04),
+		Flags:                        BIOSLanguageFlags(GetByte(s, 0x05)),
 	}
+
+	// The current language string number is only present in structures of at least 16h bytes.
+	if s.Header.Length >= 0x16 {
+		b.CurrentLanguage = GetStringOrEmpty(s, 0x15)
+	}
+
+	languages := GetStrings(s)
+	if n := int(b.NumberOfInstallableLanguages); n < len(languages) {
+		languages = languages[:n]
+	}
+
+	if len(languages) > 0 {
+		b.InstallableLanguages = languages
+	}
+
+	return b
+}
+
+// Languages returns the parsed installable languages.
+func (b BIOSLanguageInformation) Languages() []BIOSLanguage {
+	languages := make([]BIOSLanguage, 0, len(b.InstallableLanguages))
+
+	for _, language := range b.InstallableLanguages {
+		languages = append(languages, ParseBIOSLanguage(language))
+	}
+
+	return languages
+}
+
+// Current returns the parsed current language.
+func (b BIOSLanguageInformation) Current() BIOSLanguage {
+	return ParseBIOSLanguage(b.CurrentLanguage)
+}
+
+// BIOSLanguageFlags represents the BIOS language flags.
+type BIOSLanguageFlags uint8
+
+// Abbreviated returns true if the language strings use the abbreviated format.
+func (b BIOSLanguageFlags) Abbreviated() bool {
+	return IsNthBitSet(int(b), 0)
+}
+
+// BIOSLanguage represents a parsed BIOS language string.
+type BIOSLanguage struct {
+	// Language returns the ISO 639 language code, e.g. "en".
+	Language string
+	// Territory returns the ISO 3166 territory code, e.g. "US".
+	Territory string
+	// Encoding returns the encoding method, e.g. "iso8859-1".
+	// It is empty for abbreviated language strings.
+	Encoding string
+}
+
+// String returns the string representation of a `BIOSLanguage`.
+func (b BIOSLanguage) String() string {
+	if b.Encoding == "" {
+		return b.Language + b.Territory
+	}
+
+	return b.Language + "|" + b.Territory + "|" + b.Encoding
+}
+
+// ParseBIOSLanguage parses a BIOS language string in either the long
+// ("en|US|iso8859-1") or the abbreviated ("enUS") format.
+// The format is detected from the string itself, as the abbreviated format
+// flag is not always reliable.
+func ParseBIOSLanguage(s string) BIOSLanguage {
+	if strings.Contains(s, "|") {
+		parts := strings.SplitN(s, "|", 3)
+		parts = append(parts, "", "")
+
+		return BIOSLanguage{
+			Language:  parts[0],
+			Territory: parts[1],
+			Encoding:  parts[2],
+		}
+	}
+
+	if len(s) == 4 {
+		return BIOSLanguage{
+			Language:  s[:2],
+			Territory: s[2:],
+		}
+	}
+
+	return BIOSLanguage{Language: s}
 }
@@ -4,17 +4,409 @@
 
 package smbios
 
-import "github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
+import (
+	"fmt"
+	"strings"
+)
 
 // CacheInformation represents the SMBIOS cache information.
+//
+//nolint:govet
 type CacheInformation struct {
+	// Handle returns the structure handle.
+	Handle CacheHandle
 	// SocketDesignation returns the cache socket designation.
 	SocketDesignation string
+	// CacheConfiguration returns the cache configuration.
+	CacheConfiguration CacheConfiguration
+	// MaximumCacheSize returns the maximum size that can be installed.
+	// If the size is 2047 MB or greater, the field is set to FFFFh
+	// and the actual size is stored in the Maximum Cache Size 2 field.
+	MaximumCacheSize CacheSize
+	// InstalledSize returns the installed size. A value of 0 indicates
+	// that no cache is installed. If the size is 2047 MB or greater,
+	// the field is set to FFFFh and the actual size is stored in
+	// the Installed Cache Size 2 field.
+	InstalledSize CacheSize
+	// SupportedSRAMType returns the supported SRAM types. See 7.8.2.
+	SupportedSRAMType CacheSRAMType
+	// CurrentSRAMType returns the current SRAM type. See 7.8.2.
+	CurrentSRAMType CacheSRAMType
+	// CacheSpeed returns the cache module speed, in nanoseconds.
+	// The value is 0 if the speed is unknown.
+	CacheSpeed uint8
+	// ErrorCorrectionType returns the error-correction scheme supported
+	// by this cache component. See 7.8.3.
+	ErrorCorrectionType CacheErrorCorrectionType
+	// SystemCacheType returns the logical type of cache. See 7.8.4.
+	SystemCacheType SystemCacheType
+	// Associativity returns the associativity of the cache. See 7.8.5.
+	Associativity CacheAssociativity
+	// MaximumCacheSize2 returns the maximum size that can be installed,
+	// for caches of 2047 MB or greater.
+	MaximumCacheSize2 CacheSize2
+	// InstalledCacheSize2 returns the installed size,
+	// for caches of 2047 MB or greater.
+	InstalledCacheSize2 CacheSize2
+
+	// FieldPresence returns the fields that the structure was too short to contain.
+	FieldPresence
 }
 
 // NewCacheInformation initializes and returns a new `CacheInformation`.
-func NewCacheInformation(s *smbios.Structure) *CacheInformation {
+func NewCacheInformation(s *Structure) *CacheInformation {
 	return &CacheInformation{
-		SocketDesignation: GetStringOrEmpty(s, 0x04),
+		Handle:              CacheHandle(s.Header.Handle),
+		SocketDesignation:   GetStringOrEmpty(s, 0x04),
+		CacheConfiguration:  CacheConfiguration(GetWord(s, 0x05)),
+		MaximumCacheSize:    CacheSize(GetWord(s, 0x07)),
+		InstalledSize:       CacheSize(GetWord(s, 0x09)),
+		SupportedSRAMType:   CacheSRAMType(GetWord(s, 0x0B)),
+		CurrentSRAMType:     CacheSRAMType(GetWord(s, 0x0D)),
+		CacheSpeed:          GetByte(s, 0x0F),
+		ErrorCorrectionType: CacheErrorCorrectionType(GetByte(s, 0x10)),
+		SystemCacheType:     SystemCacheType(GetByte(s, 0x11)),
+		Associativity:       CacheAssociativity(GetByte(s, 0x12)),
+		MaximumCacheSize2:   CacheSize2(GetDWord(s, 0x13)),
+		InstalledCacheSize2: CacheSize2(GetDWord(s, 0x17)),
+		FieldPresence:       _GetFieldPresence(s),
 	}
 }
+
+// MarshalJSON implements `json.Marshaler`, encoding absent fields as null.
+func (c CacheInformation) MarshalJSON() ([]byte, error) {
+	return _MarshalJSONWithPresence(c, c.FieldPresence)
+}
+
+// MaximumSizeBytes returns the maximum size that can be installed, in bytes,
+// taking the Maximum Cache Size 2 field into account.
+func (c CacheInformation) MaximumSizeBytes() uint64 {
+	return _GetCacheSizeBytes(c.MaximumCacheSize, c.MaximumCacheSize2)
+}
+
+// InstalledSizeBytes returns the installed size, in bytes,
+// taking the Installed Cache Size 2 field into account.
+func (c CacheInformation) InstalledSizeBytes() uint64 {
+	return _GetCacheSizeBytes(c.InstalledSize, c.InstalledCacheSize2)
+}
+
+func _GetCacheSizeBytes(size CacheSize, size2 CacheSize2) uint64 {
+	if size == 0xFFFF && size2 != 0 {
+		return size2.Bytes()
+	}
+
+	return size.Bytes()
+}
+
+// CacheHandle represents the handle of a cache information structure.
+type CacheHandle uint16
+
+// NoCache is the cache handle value used when the processor has no cache at that level.
+const NoCache CacheHandle = 0xFFFF
+
+// String returns the string representation of a `CacheHandle`.
+func (c CacheHandle) String() string {
+	if c == NoCache {
+		return "Not Provided"
+	}
+
+	return fmt.Sprintf("0x%04X", uint16(c))
+}
+
+// CacheConfiguration represents the cache configuration.
+type CacheConfiguration uint16
+
+// Level returns the cache level, 1 through 8.
+func (c CacheConfiguration) Level() int {
+	return int(c&0x07) + 1
+}
+
+// Socketed returns true if the cache is socketed.
+func (c CacheConfiguration) Socketed() bool {
+	return IsNthBitSet(int(c), 3)
+}
+
+// Location returns the location of the cache, relative to the CPU module.
+func (c CacheConfiguration) Location() CacheLocation {
+	return CacheLocation((c >> 5) & 0x03)
+}
+
+// Enabled returns true if the cache is enabled at boot time.
+func (c CacheConfiguration) Enabled() bool {
+	return IsNthBitSet(int(c), 7)
+}
+
+// OperationalMode returns the cache operational mode.
+func (c CacheConfiguration) OperationalMode() CacheOperationalMode {
+	return CacheOperationalMode((c >> 8) & 0x03)
+}
+
+// CacheLocation represents the location of a cache, relative to the CPU module.
+type CacheLocation int
+
+const (
+	// CacheLocationInternal is a cache location.
+	CacheLocationInternal CacheLocation = iota
+	// CacheLocationExternal is a cache location.
+	CacheLocationExternal
+	// CacheLocationReserved is a cache location.
+	CacheLocationReserved
+	// CacheLocationUnknown is a cache location.
+	CacheLocationUnknown
+)
+
+// String returns the string representation of a `CacheLocation`.
+func (c CacheLocation) String() string {
+	switch c {
+	case CacheLocationInternal:
+		return "Internal"
+	case CacheLocationExternal:
+		return "External"
+	case CacheLocationReserved:
+		return _Reserved
+	case CacheLocationUnknown:
+		return _Unknown
+	}
+
+	return _Unknown
+}
+
+// CacheOperationalMode represents the cache operational mode.
+type CacheOperationalMode int
+
+const (
+	// CacheOperationalModeWriteThrough is a cache operational mode.
+	CacheOperationalModeWriteThrough CacheOperationalMode = iota
+	// CacheOperationalModeWriteBack is a cache operational mode.
+	CacheOperationalModeWriteBack
+	// CacheOperationalModeVariesWithMemoryAddress is a cache operational mode.
+	CacheOperationalModeVariesWithMemoryAddress
+	// CacheOperationalModeUnknown is a cache operational mode.
+	CacheOperationalModeUnknown
+)
+
+// String returns the string representation of a `CacheOperationalMode`.
+func (c CacheOperationalMode) String() string {
+	switch c {
+	case CacheOperationalModeWriteThrough:
+		return "Write Through"
+	case CacheOperationalModeWriteBack:
+		return "Write Back"
+	case CacheOperationalModeVariesWithMemoryAddress:
+		return "Varies With Memory Address"
+	case CacheOperationalModeUnknown:
+		return _Unknown
+	}
+
+	return _Unknown
+}
+
+// CacheSize represents a cache size. Bit 15 selects the granularity:
+// if the bit is 0, bits 14:0 are in 1K units; if the bit is 1,
+// bits 14:0 are in 64K units.
+type CacheSize uint16
+
+// Bytes returns the cache size converted to bytes.
+func (c CacheSize) Bytes() uint64 {
+	size := uint64(c & 0x7FFF)
+
+	if IsNthBitSet(int(c), 15) {
+		return size * 64 * 1024
+	}
+
+	return size * 1024
+}
+
+// String returns the string representation of a `CacheSize`.
+func (c CacheSize) String() string {
+	return fmt.Sprintf("%d kB", c.Bytes()/1024)
+}
+
+// CacheSize2 represents a cache size, for caches of 2047 MB or greater.
+// Bit 31 selects the granularity: if the bit is 0, bits 30:0 are in
+// 1K units; if the bit is 1, bits 30:0 are in 64K units.
+type CacheSize2 uint32
+
+// Bytes returns the cache size converted to bytes.
+func (c CacheSize2) Bytes() uint64 {
+	size := uint64(c & 0x7FFFFFFF)
+
+	if IsNthBitSet(int(c), 31) {
+		return size * 64 * 1024
+	}
+
+	return size * 1024
+}
+
+// String returns the string representation of a `CacheSize2`.
+func (c CacheSize2) String() string {
+	return fmt.Sprintf("%d kB", c.Bytes()/1024)
+}
+
+// CacheSRAMType represents the SRAM types of a cache.
+type CacheSRAMType uint16
+
+// cacheSRAMTypeNames maps SRAM type bits to their names.
+var cacheSRAMTypeNames = []string{
+	_Other,
+	_Unknown,
+	"Non-Burst",
+	"Burst",
+	"Pipeline Burst",
+	"Synchronous",
+	"Asynchronous",
+}
+
+// Types returns the names of the SRAM types that are set.
+func (c CacheSRAMType) Types() []string {
+	return _GetBitNames(int(c), cacheSRAMTypeNames)
+}
+
+// String returns the string representation of a `CacheSRAMType`.
+func (c CacheSRAMType) String() string {
+	return strings.Join(c.Types(), " ")
+}
+
+// CacheErrorCorrectionType represents the cache error correction type.
+type CacheErrorCorrectionType int
+
+const (
+	// CacheErrorCorrectionTypeOther is a cache error correction type.
+	CacheErrorCorrectionTypeOther CacheErrorCorrectionType = iota + 1
+	// CacheErrorCorrectionTypeUnknown is a cache error correction type.
+	CacheErrorCorrectionTypeUnknown
+	// CacheErrorCorrectionTypeNone is a cache error correction type.
+	CacheErrorCorrectionTypeNone
+	// CacheErrorCorrectionTypeParity is a cache error correction type.
+	CacheErrorCorrectionTypeParity
+	// CacheErrorCorrectionTypeSingleBitECC is a cache error correction type.
+	CacheErrorCorrectionTypeSingleBitECC
+	// CacheErrorCorrectionTypeMultiBitECC is a cache error correction type.
+	CacheErrorCorrectionTypeMultiBitECC
+)
+
+// String returns the string representation of a `CacheErrorCorrectionType`.
+func (c CacheErrorCorrectionType) String() string {
+	switch c {
+	case CacheErrorCorrectionTypeOther:
+		return _Other
+	case CacheErrorCorrectionTypeUnknown:
+		return _Unknown
+	case CacheErrorCorrectionTypeNone:
+		return "None"
+	case CacheErrorCorrectionTypeParity:
+		return "Parity"
+	case CacheErrorCorrectionTypeSingleBitECC:
+		return "Single-bit ECC"
+	case CacheErrorCorrectionTypeMultiBitECC:
+		return "Multi-bit ECC"
+	}
+
+	return _Unknown
+}
+
+// SystemCacheType represents the logical type of a cache.
+type SystemCacheType int
+
+const (
+	// SystemCacheTypeOther is a system cache type.
+	SystemCacheTypeOther SystemCacheType = iota + 1
+	// SystemCacheTypeUnknown is a system cache type.
+	SystemCacheTypeUnknown
+	// SystemCacheTypeInstruction is a system cache type.
+	SystemCacheTypeInstruction
+	// SystemCacheTypeData is a system cache type.
+	SystemCacheTypeData
+	// SystemCacheTypeUnified is a system cache type.
+	SystemCacheTypeUnified
+)
+
+// String returns the string representation of a `SystemCacheType`.
+func (c SystemCacheType) String() string {
+	switch c {
+	case SystemCacheTypeOther:
+		return _Other
+	case SystemCacheTypeUnknown:
+		return _Unknown
+	case SystemCacheTypeInstruction:
+		return "Instruction"
+	case SystemCacheTypeData:
+		return "Data"
+	case SystemCacheTypeUnified:
+		return "Unified"
+	}
+
+	return _Unknown
+}
+
+// CacheAssociativity represents the associativity of a cache.
+type CacheAssociativity int
+
+const (
+	// CacheAssociativityOther is a cache associativity.
+	CacheAssociativityOther CacheAssociativity = iota + 1
+	// CacheAssociativityUnknown is a cache associativity.
+	CacheAssociativityUnknown
+	// CacheAssociativityDirectMapped is a cache associativity.
+	CacheAssociativityDirectMapped
+	// CacheAssociativity2Way is a cache associativity.
+	CacheAssociativity2Way
+	// CacheAssociativity4Way is a cache associativity.
+	CacheAssociativity4Way
+	// CacheAssociativityFullyAssociative is a cache associativity.
+	CacheAssociativityFullyAssociative
+	// CacheAssociativity8Way is a cache associativity.
+	CacheAssociativity8Way
+	// CacheAssociativity16Way is a cache associativity.
+	CacheAssociativity16Way
+	// CacheAssociativity12Way is a cache associativity.
+	CacheAssociativity12Way
+	// CacheAssociativity24Way is a cache associativity.
+	CacheAssociativity24Way
+	// CacheAssociativity32Way is a cache associativity.
+	CacheAssociativity32Way
+	// CacheAssociativity48Way is a cache associativity.
+	CacheAssociativity48Way
+	// CacheAssociativity64Way is a cache associativity.
+	CacheAssociativity64Way
+	// CacheAssociativity20Way is a cache associativity.
+	CacheAssociativity20Way
+)
+
+// String returns the string representation of a `CacheAssociativity`.
+//
+//nolint:gocyclo,cyclop
+func (c CacheAssociativity) String() string {
+	switch c {
+	case CacheAssociativityOther:
+		return _Other
+	case CacheAssociativityUnknown:
+		return _Unknown
+	case CacheAssociativityDirectMapped:
+		return "Direct Mapped"
+	case CacheAssociativity2Way:
+		return "2-way Set-associative"
+	case CacheAssociativity4Way:
+		return "4-way Set-associative"
+	case CacheAssociativityFullyAssociative:
+		return "Fully Associative"
+	case CacheAssociativity8Way:
+		return "8-way Set-associative"
+	case CacheAssociativity16Way:
+		return "16-way Set-associative"
+	case CacheAssociativity12Way:
+		return "12-way Set-associative"
+	case CacheAssociativity24Way:
+		return "24-way Set-associative"
+	case CacheAssociativity32Way:
+		return "32-way Set-associative"
+	case CacheAssociativity48Way:
+		return "48-way Set-associative"
+	case CacheAssociativity64Way:
+		return "64-way Set-associative"
+	case CacheAssociativity20Way:
+		return "20-way Set-associative"
+	}
+
+	return _Unknown
+}
@@ -0,0 +1,50 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smbios
+
+import "fmt"
+
+// Capacity represents a memory capacity, in bytes.
+type Capacity uint64
+
+// Common capacity units.
+const (
+	// Kilobyte is 1024 bytes.
+	Kilobyte Capacity = 1 << (10 * (iota + 1))
+	// Megabyte is 1024 kilobytes.
+	Megabyte
+	// Gigabyte is 1024 megabytes.
+	Gigabyte
+	// Terabyte is 1024 gigabytes.
+	Terabyte
+	// Petabyte is 1024 terabytes.
+	Petabyte
+	// Exabyte is 1024 petabytes.
+	Exabyte
+)
+
+// Bytes returns the capacity in bytes.
+func (c Capacity) Bytes() uint64 {
+	return uint64(c)
+}
+
+// String returns the human readable representation of a `Capacity`,
+// using the largest binary unit that represents it exactly,
+// e.g. "16 GB", "1536 MB" or "256 KB".
+func (c Capacity) String() string {
+	if c == 0 {
+		return "0 bytes"
+	}
+
+	units := []string{"bytes", "KB", "MB", "GB", "TB", "PB", "EB"}
+
+	unit := 0
+	for c%1024 == 0 && unit < len(units)-1 {
+		c /= 1024
+		unit++
+	}
+
+	return fmt.Sprintf("%d %s", uint64(c), units[unit])
+}
@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smbios
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
+)
+
+// Decoder decodes structures from a stream one at a time, without
+// materializing the whole table.
+type Decoder struct {
+	d    *smbios.Decoder
+	done bool
+}
+
+// NewDecoder initializes and returns a new `Decoder` reading from the provided `Reader`.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{
+		d: smbios.NewDecoder(r),
+	}
+}
+
+// Next decodes and returns the next structure. The End-of-Table structure
+// is returned as any other structure, after which Next returns `io.EOF`.
+func (d *Decoder) Next() (*Structure, error) {
+	if d.done {
+		return nil, io.EOF
+	}
+
+	structure, err := d.d.Next()
+	if err != nil {
+		return nil, fmt.Errorf("next error: %w", err)
+	}
+
+	if structure.Header.Type == 127 {
+		d.done = true
+	}
+
+	return _NewStructure(structure), nil
+}
+
+// Walk calls fn for each remaining structure, up to and including the End-of-Table
+// structure. It stops early, without reading the rest of the stream, when fn returns false.
+func (d *Decoder) Walk(fn func(*Structure) bool) error {
+	for {
+		structure, err := d.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if !fn(structure) {
+			return nil
+		}
+	}
+}
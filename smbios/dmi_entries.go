@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smbios
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// DefaultDMIEntriesPath is the default sysfs directory exposing each structure of the table.
+const DefaultDMIEntriesPath = "/sys/firmware/dmi/entries"
+
+// dmiEntry represents a structure exposed in sysfs.
+type dmiEntry struct {
+	position int
+	raw      []byte
+}
+
+// ReadDMIEntries reads the structures exposed by Linux as `<type>-<instance>` directories
+// under the given root, usually `DefaultDMIEntriesPath`, and returns the table they make up,
+// in table order.
+// It is available on some systems where the table itself is not exposed.
+func ReadDMIEntries(root string) ([]byte, error) {
+	dirs, err := os.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read DMI entries: %w", err)
+	}
+
+	var entries []dmiEntry
+
+	for _, dir := range dirs {
+		if !strings.Contains(dir.Name(), "-") {
+			continue
+		}
+
+		entry, err := readDMIEntry(filepath.Join(root, dir.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no DMI entries found in %s", root)
+	}
+
+	slices.SortFunc(entries, func(a, b dmiEntry) int {
+		return cmp.Compare(a.position, b.position)
+	})
+
+	var table []byte
+
+	for _, entry := range entries {
+		table = append(table, entry.raw...)
+	}
+
+	return table, nil
+}
+
+// DecodeDMIEntries decodes the structures exposed under the given root, see `ReadDMIEntries`,
+// and returns a new `SMBIOS`. As there is no entry point, the version is inferred from the structures.
+func DecodeDMIEntries(root string) (*SMBIOS, error) {
+	table, err := ReadDMIEntries(root)
+	if err != nil {
+		return nil, err
+	}
+
+	return _DecodeInferred(bytes.NewReader(table))
+}
+
+// readDMIEntry reads the structure exposed in the given directory,
+// checking its raw content against its handle and length.
+func readDMIEntry(path string) (dmiEntry, error) {
+	var values [3]int
+
+	for i, name := range []string{"position", "handle", "length"} {
+		b, err := os.ReadFile(filepath.Join(path, name))
+		if err != nil {
+			return dmiEntry{}, fmt.Errorf("failed to read DMI entry attribute: %w", err)
+		}
+
+		if values[i], err = strconv.Atoi(strings.TrimSpace(string(b))); err != nil {
+			return dmiEntry{}, fmt.Errorf("failed to parse DMI entry attribute %s: %w", filepath.Join(path, name), err)
+		}
+	}
+
+	raw, err := os.ReadFile(filepath.Join(path, "raw"))
+	if err != nil {
+		return dmiEntry{}, fmt.Errorf("failed to read DMI entry: %w", err)
+	}
+
+	if len(raw) < 4 || int(raw[1]) != values[2] || int(binary.LittleEndian.Uint16(raw[2:4])) != values[1] {
+		return dmiEntry{}, fmt.Errorf("DMI entry %s does not match its handle and length", path)
+	}
+
+	return dmiEntry{
+		position: values[0],
+		raw:      raw,
+	}, nil
+}
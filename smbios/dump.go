@@ -0,0 +1,255 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smbios
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+)
+
+// dumpTableOffset is the offset of the table in dmidecode dumps.
+const dumpTableOffset = 0x20
+
+// ReadDump reads a dump in the `dmidecode --dump-bin` format: an entry point at
+// offset 0, followed by the table at the offset given by the entry point table address,
+// which dmidecode rewrites to 20h. It returns the entry point and the table.
+func ReadDump(r io.Reader) (EntryPoint, []byte, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read dump: %w", err)
+	}
+
+	ep, err := ParseEntryPoint(bytes.NewReader(b))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse entry point: %w", err)
+	}
+
+	address, size := ep.Table()
+	if address < _GetEntryPointLength(ep) || address > len(b) {
+		return nil, nil, fmt.Errorf("table address 0x%X is out of the dump", address)
+	}
+
+	table := b[address:]
+
+	// The 64-bit entry point only gives the maximum size of the table.
+	if size < len(table) {
+		table = table[:size]
+	}
+
+	return ep, table, nil
+}
+
+// DecodeDumpFile decodes the `dmidecode --dump-bin` file at the given path and returns a new `SMBIOS`.
+func DecodeDumpFile(path string) (*SMBIOS, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint: errcheck
+	defer f.Close()
+
+	ep, table, err := ReadDump(f)
+	if err != nil {
+		return nil, err
+	}
+
+	return _DecodeTable(ep, table)
+}
+
+// WriteDump writes the table in the `dmidecode --dump-bin` format, which `ReadDump`
+// and `dmidecode --from-dump` can read back.
+// As with dmidecode, the entry point is kept with its table address rewritten to 20h,
+// and its table fields are updated from the structures being written.
+// When there is no entry point, one is made up from the version and the table.
+func (s *SMBIOS) WriteDump(w io.Writer) error {
+	var table []byte
+
+	for _, structure := range s.Structures {
+		table = append(table, _EncodeStructure(structure)...)
+	}
+
+	dumpEP, err := s._GetDumpEntryPoint(table)
+	if err != nil {
+		return err
+	}
+
+	ep, err := _MarshalDumpEntryPoint(dumpEP)
+	if err != nil {
+		return err
+	}
+
+	if _, err = w.Write(ep); err != nil {
+		return err
+	}
+
+	_, err = w.Write(table)
+
+	return err
+}
+
+// _GetDumpEntryPoint returns a copy of the entry point of the table, or makes one up,
+// with the number of structures, table length and maximum structure size of the given table.
+func (s *SMBIOS) _GetDumpEntryPoint(table []byte) (EntryPoint, error) {
+	var maxSize int
+
+	for _, structure := range s.Structures {
+		maxSize = max(maxSize, _GetStructureSize(structure))
+	}
+
+	ep := s.EntryPoint
+	if ep == nil {
+		ep = s._NewDumpEntryPoint()
+	}
+
+	if _, ok := ep.(*EntryPoint64Bit); !ok && (len(table) > math.MaxUint16 || len(s.Structures) > math.MaxUint16) {
+		return nil, fmt.Errorf("table of %d bytes and %d structures does not fit a 32-bit entry point", len(table), len(s.Structures))
+	}
+
+	switch ep := ep.(type) {
+	case *EntryPoint64Bit:
+		if len(table) > math.MaxUint32 {
+			return nil, fmt.Errorf("table of %d bytes does not fit a 64-bit entry point", len(table))
+		}
+
+		dump := *ep
+		dump.StructureTableMaxSize = uint32(len(table))
+
+		return &dump, nil
+	case *EntryPoint32Bit:
+		dump := *ep
+		dump.MaxStructureSize = uint16(maxSize)
+		dump.StructureTableLength = uint16(len(table))
+		dump.NumberStructures = uint16(len(s.Structures))
+
+		return &dump, nil
+	case *EntryPointLegacy:
+		dump := *ep
+		dump.StructureTableLength = uint16(len(table))
+		dump.NumberStructures = uint16(len(s.Structures))
+
+		return &dump, nil
+	default:
+		return nil, errors.New("unsupported entry point")
+	}
+}
+
+// _NewDumpEntryPoint makes up an entry point from the version, without the table fields.
+func (s *SMBIOS) _NewDumpEntryPoint() EntryPoint {
+	if s.Version.Major >= 3 {
+		return &EntryPoint64Bit{
+			Anchor:             "_SM3_",
+			Length:             0x18,
+			Major:              uint8(s.Version.Major),
+			Minor:              uint8(s.Version.Minor),
+			Revision:           uint8(s.Version.Revision),
+			EntryPointRevision: 1,
+		}
+	}
+
+	return &EntryPoint32Bit{
+		Anchor:             "_SM_",
+		Length:             0x1F,
+		Major:              uint8(s.Version.Major),
+		Minor:              uint8(s.Version.Minor),
+		IntermediateAnchor: "_DMI_",
+		BCDRevision:        uint8(s.Version.Major<<4 | min(s.Version.Minor, 0x0F)),
+	}
+}
+
+// _MarshalDumpEntryPoint encodes the given entry point with the table address
+// rewritten to 20h, padded to 20h bytes.
+func _MarshalDumpEntryPoint(ep EntryPoint) ([]byte, error) {
+	b := make([]byte, dumpTableOffset)
+
+	switch ep := ep.(type) {
+	case *EntryPoint64Bit:
+		if ep.Length < 0x18 || ep.Length > dumpTableOffset {
+			return nil, fmt.Errorf("invalid 64-bit entry point length %d", ep.Length)
+		}
+
+		copy(b[0:5], "_SM3_")
+		b[6] = ep.Length
+		b[7] = ep.Major
+		b[8] = ep.Minor
+		b[9] = ep.Revision
+		b[10] = ep.EntryPointRevision
+		b[11] = ep.Reserved
+		binary.LittleEndian.PutUint32(b[12:16], ep.StructureTableMaxSize)
+		binary.LittleEndian.PutUint64(b[16:24], dumpTableOffset)
+
+		_SetChecksum(b[:ep.Length], 5)
+	case *EntryPoint32Bit:
+		// Accept the 1Eh length quirk, in which case the BCD revision is not covered by the checksum.
+		if ep.Length < 0x1E || ep.Length > dumpTableOffset {
+			return nil, fmt.Errorf("invalid 32-bit entry point length %d", ep.Length)
+		}
+
+		copy(b[0:4], "_SM_")
+		b[5] = ep.Length
+		b[6] = ep.Major
+		b[7] = ep.Minor
+		binary.LittleEndian.PutUint16(b[8:10], ep.MaxStructureSize)
+		b[10] = ep.EntryPointRevision
+		copy(b[11:16], ep.FormattedArea[:])
+		_MarshalLegacyEntryPoint(b[16:31], ep.StructureTableLength, ep.NumberStructures, ep.BCDRevision)
+
+		_SetChecksum(b[:ep.Length], 4)
+	case *EntryPointLegacy:
+		_MarshalLegacyEntryPoint(b[:EntryPointLegacyLength], ep.StructureTableLength, ep.NumberStructures, ep.BCDRevision)
+	default:
+		return nil, errors.New("unsupported entry point")
+	}
+
+	return b, nil
+}
+
+// _MarshalLegacyEntryPoint encodes a legacy entry point, which is also the
+// intermediate entry point of the 32-bit entry point, with the table address rewritten to 20h.
+func _MarshalLegacyEntryPoint(b []byte, length, count uint16, bcdRevision uint8) {
+	copy(b[0:5], "_DMI_")
+	binary.LittleEndian.PutUint16(b[6:8], length)
+	binary.LittleEndian.PutUint32(b[8:12], dumpTableOffset)
+	binary.LittleEndian.PutUint16(b[12:14], count)
+	b[14] = bcdRevision
+
+	_SetChecksum(b, 5)
+}
+
+// _SetChecksum sets the checksum byte at the given index so that the bytes add up to 0.
+func _SetChecksum(b []byte, index int) {
+	var sum uint8
+
+	b[index] = 0
+
+	for _, c := range b {
+		sum += c
+	}
+
+	b[index] = -sum
+}
+
+// _EncodeStructure encodes the given structure as in the table.
+func _EncodeStructure(s *Structure) []byte {
+	b := []byte{s.Header.Type, uint8(4 + len(s.Formatted))}
+	b = binary.LittleEndian.AppendUint16(b, s.Header.Handle)
+	b = append(b, s.Formatted...)
+
+	if len(s.Strings) == 0 {
+		return append(b, 0, 0)
+	}
+
+	for _, str := range s.Strings {
+		b = append(b, str...)
+		b = append(b, 0)
+	}
+
+	return append(b, 0)
+}
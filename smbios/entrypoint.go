@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smbios
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
+)
+
+// EntryPoint represents a SMBIOS entry point.
+// Use a type assertion to access the detailed entry point information.
+type EntryPoint = smbios.EntryPoint
+
+// EntryPoint32Bit represents the SMBIOS 32-bit entry point, used starting in SMBIOS 2.1.
+type EntryPoint32Bit = smbios.EntryPoint32Bit
+
+// EntryPoint64Bit represents the SMBIOS 64-bit entry point, used starting in SMBIOS 3.0.
+type EntryPoint64Bit = smbios.EntryPoint64Bit
+
+// EntryPointLegacy represents the legacy DMI entry point, provided alone by very old systems.
+type EntryPointLegacy = smbios.EntryPointLegacy
+
+// EntryPointLegacyLength is the length of the legacy DMI entry point, which has no length field.
+const EntryPointLegacyLength = smbios.LengthLegacy
+
+// ParseEntryPoint parses and verifies an entry point, including its checksums.
+func ParseEntryPoint(r io.Reader) (EntryPoint, error) {
+	return smbios.ParseEntryPoint(r)
+}
+
+// TableDiscrepancy represents a mismatch between the entry point and the decoded table.
+type TableDiscrepancy struct {
+	// Field returns the name of the entry point field, as in the specification.
+	Field string
+	// EntryPoint returns the value of the field in the entry point.
+	EntryPoint int
+	// Table returns the value found in the decoded table.
+	Table int
+}
+
+// String returns the string representation of a `TableDiscrepancy`.
+func (d TableDiscrepancy) String() string {
+	return fmt.Sprintf("%s: entry point has %d, table has %d", d.Field, d.EntryPoint, d.Table)
+}
+
+// TableDiscrepancies cross-checks the decoded table against its entry point:
+// the number of structures, the table length or maximum size, and the maximum structure size.
+// It returns nil if there is no entry point.
+func (s *SMBIOS) TableDiscrepancies() []TableDiscrepancy {
+	var (
+		discrepancies []TableDiscrepancy
+		length        int
+		maxSize       int
+	)
+
+	structures := s.Structures
+
+	// The decoder makes up a zero-length End-of-Table structure for truncated tables
+	// and for the zero-length structure of Hyper-V, which is not part of the table.
+	if n := len(structures); n > 0 && structures[n-1].Header.Length == 0 {
+		structures = structures[:n-1]
+	}
+
+	for _, structure := range structures {
+		size := _GetStructureSize(structure)
+
+		length += size
+		maxSize = max(maxSize, size)
+	}
+
+	switch ep := s.EntryPoint.(type) {
+	case *EntryPoint32Bit:
+		if int(ep.NumberStructures) != len(structures) {
+			discrepancies = append(discrepancies, TableDiscrepancy{"Number of SMBIOS Structures", int(ep.NumberStructures), len(structures)})
+		}
+
+		if int(ep.StructureTableLength) != length {
+			discrepancies = append(discrepancies, TableDiscrepancy{"Structure Table Length", int(ep.StructureTableLength), length})
+		}
+
+		if int(ep.MaxStructureSize) != maxSize {
+			discrepancies = append(discrepancies, TableDiscrepancy{"Maximum Structure Size", int(ep.MaxStructureSize), maxSize})
+		}
+	case *EntryPointLegacy:
+		if int(ep.NumberStructures) != len(structures) {
+			discrepancies = append(discrepancies, TableDiscrepancy{"Number of SMBIOS Structures", int(ep.NumberStructures), len(structures)})
+		}
+
+		if int(ep.StructureTableLength) != length {
+			discrepancies = append(discrepancies, TableDiscrepancy{"Structure Table Length", int(ep.StructureTableLength), length})
+		}
+	case *EntryPoint64Bit:
+		if int(ep.StructureTableMaxSize) < length {
+			discrepancies = append(discrepancies, TableDiscrepancy{"Structure Table Maximum Size", int(ep.StructureTableMaxSize), length})
+		}
+	}
+
+	return discrepancies
+}
+
+// _GetStructureSize returns the size of the given structure in the table,
+// including its header, formatted area and strings.
+func _GetStructureSize(s *Structure) int {
+	size := 4 + len(s.Formatted)
+
+	if len(s.Strings) == 0 {
+		return size + 2
+	}
+
+	for _, str := range s.Strings {
+		size += len(str) + 1
+	}
+
+	return size + 1
+}
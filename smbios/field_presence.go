@@ -0,0 +1,221 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smbios
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strconv"
+)
+
+// Field describes a field of a decoded type that a structure may be too short to contain,
+// either because it predates the specification version that introduced the field,
+// or because the firmware does not fill it.
+type Field struct {
+	// Name returns the name of the field in the decoded type.
+	Name string
+	// Offset returns the offset of the field, as in the specification.
+	// For fields that follow a variable-length area, it is the offset when the area is empty.
+	Offset int
+	// Size returns the size of the field, in bytes.
+	Size int
+	// Since returns the specification version that introduced the field.
+	Since Version
+}
+
+// structureFields maps structure types to their optional fields, in offset order.
+var structureFields = map[uint8][]Field{
+	1: {
+		{"UUID", 0x08, 16, Version{Major: 2, Minor: 1}},
+		{"WakeUpType", 0x18, 1, Version{Major: 2, Minor: 1}},
+		{"SKUNumber", 0x19, 1, Version{Major: 2, Minor: 4}},
+		{"Family", 0x1A, 1, Version{Major: 2, Minor: 4}},
+	},
+	2: {
+		{"AssetTag", 0x08, 1, Version{Major: 2, Minor: 0}},
+		{"LocationInChassis", 0x0A, 1, Version{Major: 2, Minor: 0}},
+		{"BoardType", 0x0D, 1, Version{Major: 2, Minor: 0}},
+	},
+	3: {
+		{"BootUpState", 0x09, 1, Version{Major: 2, Minor: 1}},
+		{"PowerSupplyState", 0x0A, 1, Version{Major: 2, Minor: 1}},
+		{"ThermalState", 0x0B, 1, Version{Major: 2, Minor: 1}},
+		{"SecurityStatus", 0x0C, 1, Version{Major: 2, Minor: 1}},
+		{"OEMDefined", 0x0D, 4, Version{Major: 2, Minor: 3}},
+		{"Height", 0x11, 1, Version{Major: 2, Minor: 3}},
+		{"NumberOfPowerCords", 0x12, 1, Version{Major: 2, Minor: 3}},
+		{"ContainedElements", 0x13, 2, Version{Major: 2, Minor: 3}},
+		{"SKUNumber", 0x15, 1, Version{Major: 2, Minor: 7}},
+	},
+	4: {
+		{"L1CacheHandle", 0x1A, 2, Version{Major: 2, Minor: 1}},
+		{"L2CacheHandle", 0x1C, 2, Version{Major: 2, Minor: 1}},
+		{"L3CacheHandle", 0x1E, 2, Version{Major: 2, Minor: 1}},
+		{"SerialNumber", 0x20, 1, Version{Major: 2, Minor: 3}},
+		{"AssetTag", 0x21, 1, Version{Major: 2, Minor: 3}},
+		{"PartNumber", 0x22, 1, Version{Major: 2, Minor: 3}},
+		{"CoreCount", 0x23, 1, Version{Major: 2, Minor: 5}},
+		{"CoreEnabled", 0x24, 1, Version{Major: 2, Minor: 5}},
+		{"ThreadCount", 0x25, 1, Version{Major: 2, Minor: 5}},
+		{"ProcessorCharacteristics", 0x26, 2, Version{Major: 2, Minor: 5}},
+		{"ProcessorFamily2", 0x28, 2, Version{Major: 2, Minor: 6}},
+		{"CoreCount2", 0x2A, 2, Version{Major: 3, Minor: 0}},
+		{"CoreEnabled2", 0x2C, 2, Version{Major: 3, Minor: 0}},
+		{"ThreadCount2", 0x2E, 2, Version{Major: 3, Minor: 0}},
+		{"ThreadEnabled", 0x30, 2, Version{Major: 3, Minor: 6}},
+		{"SocketType", 0x32, 1, Version{Major: 3, Minor: 6}},
+	},
+	7: {
+		{"CacheSpeed", 0x0F, 1, Version{Major: 2, Minor: 1}},
+		{"ErrorCorrectionType", 0x10, 1, Version{Major: 2, Minor: 1}},
+		{"SystemCacheType", 0x11, 1, Version{Major: 2, Minor: 1}},
+		{"Associativity", 0x12, 1, Version{Major: 2, Minor: 1}},
+		{"MaximumCacheSize2", 0x13, 4, Version{Major: 3, Minor: 1}},
+		{"InstalledCacheSize2", 0x17, 4, Version{Major: 3, Minor: 1}},
+	},
+	9: {
+		{"SlotCharacteristics2", 0x0C, 1, Version{Major: 2, Minor: 1}},
+		{"SegmentGroupNumber", 0x0D, 2, Version{Major: 2, Minor: 6}},
+		{"BusNumber", 0x0F, 1, Version{Major: 2, Minor: 6}},
+		{"DeviceFunctionNumber", 0x10, 1, Version{Major: 2, Minor: 6}},
+		{"DataBusWidth", 0x11, 1, Version{Major: 3, Minor: 2}},
+		{"PeerGroups", 0x12, 1, Version{Major: 3, Minor: 2}},
+		{"SlotInformation", 0x13, 1, Version{Major: 3, Minor: 4}},
+		{"SlotPhysicalWidth", 0x14, 1, Version{Major: 3, Minor: 4}},
+		{"SlotPitch", 0x15, 2, Version{Major: 3, Minor: 4}},
+		{"SlotHeight", 0x17, 1, Version{Major: 3, Minor: 5}},
+	},
+	16: {
+		{"ExtendedMaximumCapacity", 0x0F, 8, Version{Major: 2, Minor: 7}},
+	},
+	17: {
+		{"Speed", 0x15, 2, Version{Major: 2, Minor: 3}},
+		{"Manufacturer", 0x17, 1, Version{Major: 2, Minor: 3}},
+		{"SerialNumber", 0x18, 1, Version{Major: 2, Minor: 3}},
+		{"AssetTag", 0x19, 1, Version{Major: 2, Minor: 3}},
+		{"PartNumber", 0x1A, 1, Version{Major: 2, Minor: 3}},
+		{"Attributes", 0x1B, 1, Version{Major: 2, Minor: 6}},
+		{"ExtendedSize", 0x1C, 4, Version{Major: 2, Minor: 7}},
+		{"ConfiguredMemorySpeed", 0x20, 2, Version{Major: 2, Minor: 7}},
+		{"MinimumVoltage", 0x22, 2, Version{Major: 2, Minor: 8}},
+		{"MaximumVoltage", 0x24, 2, Version{Major: 2, Minor: 8}},
+		{"ConfiguredVoltage", 0x26, 2, Version{Major: 2, Minor: 8}},
+		{"MemoryTechnology", 0x28, 1, Version{Major: 3, Minor: 2}},
+		{"MemoryOperatingModeCapability", 0x29, 2, Version{Major: 3, Minor: 2}},
+		{"FirmwareVersion", 0x2B, 1, Version{Major: 3, Minor: 2}},
+		{"ModuleManufacturerID", 0x2C, 2, Version{Major: 3, Minor: 2}},
+		{"ModuleProductID", 0x2E, 2, Version{Major: 3, Minor: 2}},
+		{"MemorySubsystemControllerManufacturerID", 0x30, 2, Version{Major: 3, Minor: 2}},
+		{"MemorySubsystemControllerProductID", 0x32, 2, Version{Major: 3, Minor: 2}},
+		{"NonVolatileSize", 0x34, 8, Version{Major: 3, Minor: 2}},
+		{"VolatileSize", 0x3C, 8, Version{Major: 3, Minor: 2}},
+		{"CacheSize", 0x44, 8, Version{Major: 3, Minor: 2}},
+		{"LogicalSize", 0x4C, 8, Version{Major: 3, Minor: 2}},
+		{"ExtendedSpeed", 0x54, 4, Version{Major: 3, Minor: 3}},
+		{"ExtendedConfiguredMemorySpeed", 0x58, 4, Version{Major: 3, Minor: 3}},
+		{"PMIC0ManufacturerID", 0x5C, 2, Version{Major: 3, Minor: 7}},
+		{"PMIC0RevisionNumber", 0x5E, 2, Version{Major: 3, Minor: 7}},
+		{"RCDManufacturerID", 0x60, 2, Version{Major: 3, Minor: 7}},
+		{"RCDRevisionNumber", 0x62, 2, Version{Major: 3, Minor: 7}},
+	},
+}
+
+// StructureFields returns the fields of the given structure type that a structure may be too short to contain.
+func StructureFields(t uint8) []Field {
+	return slices.Clone(structureFields[t])
+}
+
+// HasField returns true if the structure is long enough to contain the given field.
+func (s *Structure) HasField(f Field) bool {
+	offset := f.Offset
+
+	// Account for the variable-length areas preceding the field.
+	switch s.Header.Type {
+	case 3:
+		if offset >= 0x15 {
+			offset += int(GetByte(s, 0x13)) * int(GetByte(s, 0x14))
+		}
+	case 9:
+		if offset >= 0x13 {
+			offset += 5 * int(GetByte(s, 0x12))
+		}
+	}
+
+	return offset+f.Size <= int(s.Header.Length)
+}
+
+// FieldPresence records the fields that a decoded structure was too short to contain.
+// Absent fields hold their zero value, and are encoded as null in JSON.
+type FieldPresence struct {
+	// AbsentFields returns the names of the fields that the structure was too short to contain.
+	AbsentFields []string `json:",omitempty"`
+}
+
+// Has returns true if the structure contained the field with the given name.
+func (p FieldPresence) Has(name string) bool {
+	return !slices.Contains(p.AbsentFields, name)
+}
+
+// _GetFieldPresence returns the presence of the optional fields of the given structure.
+func _GetFieldPresence(s *Structure) FieldPresence {
+	var p FieldPresence
+
+	for _, f := range structureFields[s.Header.Type] {
+		if !s.HasField(f) {
+			p.AbsentFields = append(p.AbsentFields, f.Name)
+		}
+	}
+
+	return p
+}
+
+// _MarshalJSONWithPresence encodes a decoded type as JSON, encoding absent fields as null.
+func _MarshalJSONWithPresence(v any, p FieldPresence) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	rt := rv.Type()
+
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+
+	for i := range rt.NumField() {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, value := field.Name, rv.Field(i).Interface()
+
+		switch {
+		case field.Type == reflect.TypeOf(p):
+			if len(p.AbsentFields) == 0 {
+				continue
+			}
+
+			name, value = "AbsentFields", p.AbsentFields
+		case !p.Has(name):
+			value = nil
+		}
+
+		b, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+
+		buf.WriteString(strconv.Quote(name))
+		buf.WriteByte(':')
+		buf.Write(b)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
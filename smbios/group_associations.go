@@ -4,17 +4,159 @@
 
 package smbios
 
-import "github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
-
 // GroupAssociations represents the SMBIOS group associations.
 type GroupAssociations struct {
 	// GroupName returns the group name.
 	GroupName string
+	// Items returns the items of the group.
+	Items []GroupAssociationItem
+}
+
+// GroupAssociationItem represents an item of a group association.
+type GroupAssociationItem struct {
+	// ItemType returns the structure type of the item.
+	ItemType uint8
+	// ItemHandle returns the handle of the item structure.
+	ItemHandle uint16
 }
 
 // NewGroupAssociations initializes and returns a new `GroupAssociations`.
-func NewGroupAssociations(s *smbios.Structure) *GroupAssociations {
+func NewGroupAssociations(s *Structure) *GroupAssociations {
 	return &GroupAssociations{
 		GroupName: GetStringOrEmpty(s, 0x04),
+		Items:     _GetGroupAssociationItems(s),
+	}
+}
+
+// _GetGroupAssociationItems retrieves the 3-byte (type, handle) items following the group name.
+func _GetGroupAssociationItems(s *Structure) []GroupAssociationItem {
+	n := (int(s.Header.Length) - 0x05) / 3
+	if n <= 0 {
+		return nil
+	}
+
+	items := make([]GroupAssociationItem, 0, n)
+
+	for i := range n {
+		offset := 0x05 + 3*i
+
+		items = append(items, GroupAssociationItem{
+			ItemType:   GetByte(s, offset),
+			ItemHandle: GetWord(s, offset+1),
+		})
+	}
+
+	return items
+}
+
+// GroupMember represents a group association item resolved to its decoded structure.
+type GroupMember struct {
+	GroupAssociationItem
+
+	// Value returns a pointer to the decoded structure, such as `*ProcessorInformation`
+	// or `*MemoryDevice`. It is nil if the structure is missing, its type does not
+	// match the item type, or the structure type is not decoded.
+	Value any
+}
+
+// GroupMembers resolves the items of the given group to their decoded structures.
+func (s *SMBIOS) GroupMembers(g GroupAssociations) []GroupMember {
+	members := make([]GroupMember, 0, len(g.Items))
+
+	for _, item := range g.Items {
+		member := GroupMember{GroupAssociationItem: item}
+
+		if structureType, index, count, ok := s._LocateHandle(item.ItemHandle); ok && structureType == item.ItemType {
+			member.Value = s._Decoded(structureType, index, count)
+		}
+
+		members = append(members, member)
 	}
+
+	return members
+}
+
+// _LocateHandle returns the type of the structure with the given handle, its index
+// among the structures of the same type, and the number of structures of that type.
+func (s *SMBIOS) _LocateHandle(handle uint16) (structureType uint8, index, count int, ok bool) {
+	structure := s.ByHandle(handle)
+	if structure == nil {
+		return 0, 0, 0, false
+	}
+
+	structureType, ok = structure.Header.Type, true
+
+	for _, structure := range s.Structures {
+		if structure.Header.Type != structureType {
+			continue
+		}
+
+		if structure.Header.Handle == handle {
+			index = count
+		}
+
+		count++
+	}
+
+	return structureType, index, count, true
+}
+
+// _Decoded returns a pointer to the decoded value of the index-th structure of the given type.
+// Types decoded as a single value hold the last structure of that type.
+//
+//nolint:gocyclo,cyclop
+func (s *SMBIOS) _Decoded(structureType uint8, index, count int) any {
+	last := index == count-1
+
+	switch structureType {
+	case 0:
+		if last {
+			return &s.BIOSInformation
+		}
+	case 1:
+		if last {
+			return &s.SystemInformation
+		}
+	case 2:
+		if last {
+			return &s.BaseboardInformation
+		}
+	case 3:
+		return _GetItem(s.SystemEnclosure, index)
+	case 4:
+		return _GetItem(s.ProcessorInformation, index)
+	case 7:
+		return _GetItem(s.CacheInformation, index)
+	case 8:
+		return _GetItem(s.PortConnectorInformation, index)
+	case 9:
+		return _GetItem(s.SystemSlots, index)
+	case 11:
+		return _GetItem(s.OEMStrings, index)
+	case 12:
+		if last {
+			return &s.SystemConfigurationOptions
+		}
+	case 13:
+		return _GetItem(s.BIOSLanguageInformation, index)
+	case 14:
+		return _GetItem(s.GroupAssociations, index)
+	case 16:
+		return _GetItem(s.PhysicalMemoryArray, index)
+	case 17:
+		return _GetItem(s.MemoryDevices, index)
+	case 41:
+		return _GetItem(s.OnboardDevices, index)
+	}
+
+	return nil
+}
+
+// _GetItem returns a pointer to the index-th item, or nil if out of range.
+func _GetItem[T any](items []T, index int) any {
+	if index < 0 || index >= len(items) {
+		return nil
+	}
+
+	return &items[index]
 }
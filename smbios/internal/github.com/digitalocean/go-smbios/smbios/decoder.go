@@ -77,7 +77,7 @@ func (d *Decoder) Decode() ([]*Structure, error) {
 	var ss []*Structure
 
 	for {
-		s, err := d.next()
+		s, err := d.Next()
 		if err != nil {
 			return nil, fmt.Errorf("next error: %w", err)
 		}
@@ -93,8 +93,8 @@ func (d *Decoder) Decode() ([]*Structure, error) {
 	return ss, nil
 }
 
-// next decodes the next Structure from the stream.
-func (d *Decoder) next() (*Structure, error) {
+// Next decodes the next Structure from the stream.
+func (d *Decoder) Next() (*Structure, error) {
 	h, err := d.parseHeader()
 	if err != nil {
 		return nil, err
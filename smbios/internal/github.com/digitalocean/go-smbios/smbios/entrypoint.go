@@ -61,6 +61,8 @@ func ParseEntryPoint(r io.Reader) (EntryPoint, error) {
 		return parse32(b)
 	case bytes.HasPrefix(b, magic64):
 		return parse64(b)
+	case bytes.HasPrefix(b, magicDMI):
+		return parseLegacy(b)
 	}
 
 	return nil, fmt.Errorf("unrecognized SMBIOS entry point magic: %v", b[0:4])
@@ -93,7 +95,16 @@ func (e *EntryPoint32Bit) Table() (address, size int) {
 }
 
 // Version implements EntryPoint.
+//
+// As with dmidecode, it fixes up the invalid versions reported by some BIOS.
 func (e *EntryPoint32Bit) Version() (major, minor, revision int) {
+	switch {
+	case e.Major == 2 && (e.Minor == 0x1F || e.Minor == 0x21):
+		return 2, 3, 0
+	case e.Major == 2 && e.Minor == 0x33:
+		return 2, 6, 0
+	}
+
 	return int(e.Major), int(e.Minor), 0
 }
 
@@ -101,10 +112,16 @@ func (e *EntryPoint32Bit) Version() (major, minor, revision int) {
 func parse32(b []byte) (*EntryPoint32Bit, error) {
 	l := len(b)
 
-	// Correct minimum length as of SMBIOS 3.1.1.
-	const expLen = 31
-	if l < expLen {
-		return nil, fmt.Errorf("expected SMBIOS 32-bit entry point minimum length of at least %d, but got: %d", expLen, l)
+	// Correct minimum length as of SMBIOS 3.1.1 is 31, but some SMBIOS 2.1
+	// firmware reports 30 due to a mistake in the specification, which
+	// dmidecode accepts as well.
+	const (
+		expLen      = 31
+		expLenQuirk = 30
+	)
+
+	if l < expLenQuirk {
+		return nil, fmt.Errorf("expected SMBIOS 32-bit entry point minimum length of at least %d, but got: %d", expLenQuirk, l)
 	}
 
 	// Allow more data in the buffer than the actual length, for when the
@@ -114,6 +131,10 @@ func parse32(b []byte) (*EntryPoint32Bit, error) {
 		return nil, fmt.Errorf("expected SMBIOS 32-bit entry point actual length of at least %d, but got: %d", length, l)
 	}
 
+	if length < expLenQuirk {
+		return nil, fmt.Errorf("expected SMBIOS 32-bit entry point length of at least %d, but got: %d", expLenQuirk, length)
+	}
+
 	// Look for intermediate anchor with DMI magic.
 	iAnchor := b[16:21]
 	if !bytes.Equal(iAnchor, magicDMI) {
@@ -128,8 +149,21 @@ func parse32(b []byte) (*EntryPoint32Bit, error) {
 		return nil, err
 	}
 
-	// Since we already computed the checksum for the outer entry point,
-	// no real need to compute it for the intermediate entry point.
+	// The intermediate entry point starts with the intermediate anchor, and
+	// has its own checksum at index 5, which must also be verified.
+	const (
+		iepIndex    = 16
+		iepChkIndex = 5
+		iepLen      = 15
+	)
+
+	// The last byte is missing when the entry point is read from a file with
+	// the 30 bytes length quirk, in which case the checksum can't be verified.
+	if l >= iepIndex+iepLen {
+		if err := checksum(b[iepIndex+iepChkIndex], iepChkIndex, b[iepIndex:iepIndex+iepLen]); err != nil {
+			return nil, fmt.Errorf("intermediate: %w", err)
+		}
+	}
 
 	ep := &EntryPoint32Bit{
 		Anchor:                string(b[0:4]),
@@ -144,13 +178,73 @@ func parse32(b []byte) (*EntryPoint32Bit, error) {
 		StructureTableLength:  binary.LittleEndian.Uint16(b[22:24]),
 		StructureTableAddress: binary.LittleEndian.Uint32(b[24:28]),
 		NumberStructures:      binary.LittleEndian.Uint16(b[28:30]),
-		BCDRevision:           b[30],
 	}
-	copy(ep.FormattedArea[:], b[10:15])
+	copy(ep.FormattedArea[:], b[11:16])
+
+	if l >= expLen {
+		ep.BCDRevision = b[30]
+	}
 
 	return ep, nil
 }
 
+var _ EntryPoint = &EntryPointLegacy{}
+
+// EntryPointLegacy is the legacy DMI entry point structure, which is also the
+// intermediate entry point of the SMBIOS 32-bit Entry Point. Very old systems
+// only provide this entry point.
+type EntryPointLegacy struct {
+	Anchor                string
+	Checksum              uint8
+	StructureTableLength  uint16
+	StructureTableAddress uint32
+	NumberStructures      uint16
+	BCDRevision           uint8
+}
+
+// Table implements EntryPoint.
+func (e *EntryPointLegacy) Table() (address, size int) {
+	return int(e.StructureTableAddress), int(e.StructureTableLength)
+}
+
+// Version implements EntryPoint, decoding the BCD revision.
+func (e *EntryPointLegacy) Version() (major, minor, revision int) {
+	return int(e.BCDRevision >> 4), int(e.BCDRevision & 0x0F), 0
+}
+
+const (
+	// LengthLegacy is the length of a legacy entry point, which has no length field.
+	LengthLegacy = 15
+
+	// chkIndexLegacy is the index of the checksum byte in a legacy entry point.
+	chkIndexLegacy = 5
+)
+
+// parseLegacy parses an EntryPointLegacy from b.
+func parseLegacy(b []byte) (*EntryPointLegacy, error) {
+	l := len(b)
+
+	// Ensure expected length.
+	if l < LengthLegacy {
+		return nil, fmt.Errorf("expected legacy DMI entry point length of at least %d, but got: %d", LengthLegacy, l)
+	}
+
+	// Checksum occurs at index 5, compute and verify it.
+	chk := b[chkIndexLegacy]
+	if err := checksum(chk, chkIndexLegacy, b[:LengthLegacy]); err != nil {
+		return nil, err
+	}
+
+	return &EntryPointLegacy{
+		Anchor:                string(b[0:5]),
+		Checksum:              chk,
+		StructureTableLength:  binary.LittleEndian.Uint16(b[6:8]),
+		StructureTableAddress: binary.LittleEndian.Uint32(b[8:12]),
+		NumberStructures:      binary.LittleEndian.Uint16(b[12:14]),
+		BCDRevision:           b[14],
+	}, nil
+}
+
 var _ EntryPoint = &EntryPoint64Bit{}
 
 // EntryPoint64Bit is the SMBIOS 64-bit Entry Point structure, used starting
@@ -205,7 +299,7 @@ func parse64(b []byte) (*EntryPoint64Bit, error) {
 
 	// Checksum occurs at index 5, compute and verify it.
 	chk := b[chkIndex64]
-	if err := checksum(chk, chkIndex64, b); err != nil {
+	if err := checksum(chk, chkIndex64, b[:length]); err != nil {
 		return nil, err
 	}
 
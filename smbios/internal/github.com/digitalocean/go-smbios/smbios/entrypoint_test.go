@@ -104,6 +104,25 @@ func TestParseEntryPoint(t *testing.T) {
 				0x00,
 			},
 		},
+		{
+			name: "32, bad intermediate checksum",
+			b: []byte{
+				'_', 'S', 'M', '_',
+				0xa3,
+				0x1f,
+				0x2,
+				0x8,
+				0xd4,
+				0x1, 0x0,
+				0x0, 0x0, 0x0, 0x0, 0x0,
+				'_', 'D', 'M', 'I', '_',
+				0x96,
+				0x5f, 0xf,
+				0x0, 0x90, 0xf0, 0x7a,
+				0x43, 0x0,
+				0x28,
+			},
+		},
 		{
 			name: "32, OK",
 			b: []byte{
@@ -177,6 +196,115 @@ func TestParseEntryPoint(t *testing.T) {
 			addr: 0x7af09000, size: 0x0f5f,
 			ok: true,
 		},
+		{
+			name: "32, OK, length quirk",
+			b: []byte{
+				'_', 'S', 'M', '_',
+				0xcd,
+				0x1e,
+				0x2,
+				0x8,
+				0xd4,
+				0x1, 0x0,
+				0x0, 0x0, 0x0, 0x0, 0x0,
+				'_', 'D', 'M', 'I', '_',
+				0x95,
+				0x5f, 0xf,
+				0x0, 0x90, 0xf0, 0x7a,
+				0x43, 0x0,
+			},
+			ep: &smbios.EntryPoint32Bit{
+				Anchor:                "_SM_",
+				Checksum:              0xcd,
+				Length:                0x1e,
+				Major:                 0x02,
+				Minor:                 0x08,
+				MaxStructureSize:      0x01d4,
+				IntermediateAnchor:    "_DMI_",
+				IntermediateChecksum:  0x95,
+				StructureTableLength:  0x0f5f,
+				StructureTableAddress: 0x7af09000,
+				NumberStructures:      0x43,
+			},
+			major: 2, minor: 8, revision: 0,
+			addr: 0x7af09000, size: 0x0f5f,
+			ok: true,
+		},
+		{
+			name: "32, OK, version fixup",
+			b: []byte{
+				'_', 'S', 'M', '_',
+				0x8b,
+				0x1f,
+				0x2,
+				0x21,
+				0xd4,
+				0x1, 0x0,
+				0x0, 0x0, 0x0, 0x0, 0x0,
+				'_', 'D', 'M', 'I', '_',
+				0x95,
+				0x5f, 0xf,
+				0x0, 0x90, 0xf0, 0x7a,
+				0x43, 0x0,
+				0x28,
+			},
+			ep: &smbios.EntryPoint32Bit{
+				Anchor:                "_SM_",
+				Checksum:              0x8b,
+				Length:                0x1f,
+				Major:                 0x02,
+				Minor:                 0x21,
+				MaxStructureSize:      0x01d4,
+				IntermediateAnchor:    "_DMI_",
+				IntermediateChecksum:  0x95,
+				StructureTableLength:  0x0f5f,
+				StructureTableAddress: 0x7af09000,
+				NumberStructures:      0x43,
+				BCDRevision:           0x28,
+			},
+			major: 2, minor: 3, revision: 0,
+			addr: 0x7af09000, size: 0x0f5f,
+			ok: true,
+		},
+		{
+			name: "legacy, short entry point",
+			b: []byte{
+				'_', 'D', 'M', 'I', '_',
+			},
+		},
+		{
+			name: "legacy, bad checksum",
+			b: []byte{
+				'_', 'D', 'M', 'I', '_',
+				0x00, // 0 checksum
+				0x5f, 0xf,
+				0x0, 0x90, 0xf0, 0x7a,
+				0x43, 0x0,
+				0x21,
+			},
+		},
+		{
+			name: "legacy, OK",
+			b: []byte{
+				'_', 'D', 'M', 'I', '_',
+				0x9c,
+				0x5f, 0xf,
+				0x0, 0x90, 0xf0, 0x7a,
+				0x43, 0x0,
+				0x21,
+			},
+			ep: &smbios.EntryPointLegacy{
+				Anchor:                "_DMI_",
+				Checksum:              0x9c,
+				StructureTableLength:  0x0f5f,
+				StructureTableAddress: 0x7af09000,
+				NumberStructures:      0x43,
+				BCDRevision:           0x21,
+			},
+			major: 2, minor: 1, revision: 0,
+			addr: 0x7af09000, size: 0x0f5f,
+			ok: true,
+		},
 		{
 			name: "64, short entry point",
 			b: []byte{
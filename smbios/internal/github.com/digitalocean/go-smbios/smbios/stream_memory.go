@@ -99,6 +99,18 @@ func findEntryPoint(rs io.ReadSeeker, start, end int) (int, error) {
 
 			break
 		}
+
+		// The legacy entry point is only found first when there is no
+		// 32-bit entry point, as it is then the intermediate entry point.
+		// Like dmidecode, skip stray anchors with an invalid checksum and
+		// keep scanning.
+		if bytes.HasPrefix(b, magicDMI) {
+			if _, err := parseLegacy(b); err == nil {
+				found = true
+
+				break
+			}
+		}
 	}
 
 	if !found {
@@ -123,6 +123,77 @@ func Test_memoryStream(t *testing.T) {
 			},
 			ok: true,
 		},
+		{
+			name: "32, OK, length quirk",
+			b: makeEndOfTableMemory(&EntryPoint32Bit{
+				Length:                0x1e,
+				StructureTableLength:  6,
+				StructureTableAddress: 0x00f0,
+			}),
+			ss: []*Structure{
+				{
+					Header: Header{
+						Type:   127,
+						Length: 4,
+						Handle: 1,
+					},
+				},
+			},
+			ok: true,
+		},
+		{
+			name: "legacy, OK",
+			b: makeEndOfTableMemory(&EntryPointLegacy{
+				StructureTableLength:  6,
+				StructureTableAddress: 0x00f0,
+				BCDRevision:           0x20,
+			}),
+			ss: []*Structure{
+				{
+					Header: Header{
+						Type:   127,
+						Length: 4,
+						Handle: 1,
+					},
+				},
+			},
+			ok: true,
+		},
+		{
+			name: "legacy, bad checksum",
+			b: makeMemory(
+				nil,
+				[]byte{'_', 'D', 'M', 'I', '_'},
+				nil,
+			),
+		},
+		{
+			name: "32, OK, stray legacy anchor first",
+			b: func() []byte {
+				b := makeEndOfTableMemory(&EntryPoint32Bit{
+					Length:                0x1f,
+					StructureTableLength:  6,
+					StructureTableAddress: 0x00f0,
+				})
+
+				// Move the entry point one paragraph further, behind a
+				// legacy anchor with an invalid checksum.
+				copy(b[start+16:], b[start:start+0x1f])
+				copy(b[start:start+16], []byte{'_', 'D', 'M', 'I', '_', 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+
+				return b
+			}(),
+			ss: []*Structure{
+				{
+					Header: Header{
+						Type:   127,
+						Length: 4,
+						Handle: 1,
+					},
+				},
+			},
+			ok: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -176,12 +247,29 @@ func makeMemory(before, in, after []byte) []byte {
 	return b
 }
 
+// makeEndOfTableMemory places the entry point in searchable range, and an
+// End-of-Table structure at the table address 00F0h.
+func makeEndOfTableMemory(ep EntryPoint) []byte {
+	b := makeMemory(
+		nil,
+		mustMarshalEntryPoint(ep),
+		nil,
+	)
+
+	copy(b[0x00f0:], []byte{127, 0x04, 0x01, 0x00, 0x00, 0x00})
+
+	return b
+}
+
 func mustMarshalEntryPoint(ep EntryPoint) []byte {
 	switch x := ep.(type) {
+	case *EntryPoint32Bit:
+		return marshal32(x)
 	case *EntryPoint64Bit:
 		return marshal64(x)
+	case *EntryPointLegacy:
+		return marshalLegacy(x)
 	default:
-		// TODO(mdlayher): expand with 32-bit entry point.
 		panic(fmt.Sprintf("entry point marshaling not implemented for %T", ep))
 	}
 }
@@ -216,3 +304,66 @@ func marshal64(ep *EntryPoint64Bit) []byte {
 
 	return b
 }
+
+func marshal32(ep *EntryPoint32Bit) []byte {
+	// The intermediate entry point always spans 31 bytes, even with the 30 bytes length quirk.
+	b := make([]byte, 31)
+
+	copy(b[0:4], magic32)
+	b[5] = ep.Length
+
+	b[6] = ep.Major
+	b[7] = ep.Minor
+	binary.LittleEndian.PutUint16(b[8:10], ep.MaxStructureSize)
+	b[10] = ep.EntryPointRevision
+	copy(b[11:16], ep.FormattedArea[:])
+	copy(b[16:31], marshalLegacy(&EntryPointLegacy{
+		StructureTableLength:  ep.StructureTableLength,
+		StructureTableAddress: ep.StructureTableAddress,
+		NumberStructures:      ep.NumberStructures,
+		BCDRevision:           ep.BCDRevision,
+	}))
+
+	var chk uint8
+
+	for i := range b[:ep.Length] {
+		// Explicitly skip the checksum byte for computation.
+		if i == 4 {
+			continue
+		}
+
+		chk += b[i]
+	}
+
+	// Produce the correct checksum for the entry point.
+	b[4] = uint8(256 - int(chk))
+
+	return b
+}
+
+func marshalLegacy(ep *EntryPointLegacy) []byte {
+	b := make([]byte, LengthLegacy)
+
+	copy(b[0:5], magicDMI)
+
+	binary.LittleEndian.PutUint16(b[6:8], ep.StructureTableLength)
+	binary.LittleEndian.PutUint32(b[8:12], ep.StructureTableAddress)
+	binary.LittleEndian.PutUint16(b[12:14], ep.NumberStructures)
+	b[14] = ep.BCDRevision
+
+	var chk uint8
+
+	for i := range b {
+		// Explicitly skip the checksum byte for computation.
+		if i == chkIndexLegacy {
+			continue
+		}
+
+		chk += b[i]
+	}
+
+	// Produce the correct checksum for the entry point.
+	b[chkIndexLegacy] = uint8(256 - int(chk))
+
+	return b
+}
@@ -0,0 +1,1198 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smbios
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// JEP106ID represents a JEDEC JEP106 manufacturer identification code.
+type JEP106ID struct {
+	// Bank returns the bank number, starting from 1.
+	// It is the number of continuation codes plus one.
+	Bank int
+	// Code returns the manufacturer code within the bank.
+	// The parity bit (bit 7) is ignored by lookups.
+	Code uint8
+}
+
+// Manufacturer returns the name of the manufacturer,
+// or an empty string if the ID is not known.
+func (j JEP106ID) Manufacturer() string {
+	return jep106Manufacturers[JEP106ID{Bank: j.Bank, Code: _JEP106Parity(j.Code)}]
+}
+
+// String returns the string representation of a `JEP106ID`.
+func (j JEP106ID) String() string {
+	return fmt.Sprintf("Bank %d, Hex 0x%02X", j.Bank, _JEP106Parity(j.Code))
+}
+
+// JEP106Manufacturer returns the name of the manufacturer with the given bank (starting from 1)
+// and code, or an empty string if the ID is not known.
+func JEP106Manufacturer(bank int, code uint8) string {
+	return JEP106ID{Bank: bank, Code: code}.Manufacturer()
+}
+
+// ParseJEP106 parses a manufacturer ID encoded as a hex string, as found in the
+// Manufacturer field of memory devices on some systems (e.g. "80CE", "002C00B3002C",
+// "CE00000000000000" or "7F7F7F0B00000000"). It returns false if the string
+// is not hex encoded or does not hold a known manufacturer ID.
+func ParseJEP106(s string) (JEP106ID, bool) {
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) < 2 {
+		return JEP106ID{}, false
+	}
+
+	var id JEP106ID
+
+	switch {
+	case b[0] == 0x7F:
+		// Continuation codes followed by the manufacturer code.
+		n := 0
+		for n < len(b)-1 && b[n] == 0x7F {
+			n++
+		}
+
+		id = JEP106ID{Bank: n + 1, Code: b[n]}
+	case b[0]&0x7F < 16 && b[1] != 0:
+		// Number of continuation codes followed by the manufacturer code.
+		id = JEP106ID{Bank: int(b[0]&0x7F) + 1, Code: b[1]}
+	default:
+		id = JEP106ID{Bank: 1, Code: b[0]}
+	}
+
+	if id.Manufacturer() == "" {
+		return JEP106ID{}, false
+	}
+
+	return id, true
+}
+
+// _JEP106Parity returns the code with the odd parity bit (bit 7) set as in the JEP106 table.
+func _JEP106Parity(code uint8) uint8 {
+	code &= 0x7F
+
+	ones := 0
+	for i := range 7 {
+		if IsNthBitSet(int(code), i) {
+			ones++
+		}
+	}
+
+	if ones%2 == 0 {
+		code |= 0x80
+	}
+
+	return code
+}
+
+// jep106Manufacturers maps JEP106 IDs, with the parity bit, to manufacturer names.
+// Banks 1 to 7 and 9 are complete. Banks 8 and 10 to 13 only hold the earlier
+// assignments and the SoC vendors found in Arm SoC IDs and RISC-V vendor IDs.
+var jep106Manufacturers = map[JEP106ID]string{
+	// Bank 1.
+	{1, 0x01}: "AMD",
+	{1, 0x02}: "AMI",
+	{1, 0x83}: "Fairchild",
+	{1, 0x04}: "Fujitsu",
+	{1, 0x85}: "GTE",
+	{1, 0x86}: "Harris",
+	{1, 0x07}: "Hitachi",
+	{1, 0x08}: "Inmos",
+	{1, 0x89}: "Intel",
+	{1, 0x8A}: "I.T.T.",
+	{1, 0x0B}: "Intersil",
+	{1, 0x8C}: "Monolithic Memories",
+	{1, 0x0D}: "Mostek",
+	{1, 0x0E}: "Freescale (Motorola)",
+	{1, 0x8F}: "National",
+	{1, 0x10}: "NEC",
+	{1, 0x91}: "RCA",
+	{1, 0x92}: "Raytheon",
+	{1, 0x13}: "Conexant (Rockwell)",
+	{1, 0x94}: "Seeq",
+	{1, 0x15}: "NXP (Philips)",
+	{1, 0x16}: "Synertek",
+	{1, 0x97}: "Texas Instruments",
+	{1, 0x98}: "Kioxia Corporation",
+	{1, 0x19}: "Xicor",
+	{1, 0x1A}: "Zilog",
+	{1, 0x9B}: "Eurotechnique",
+	{1, 0x1C}: "Mitsubishi",
+	{1, 0x9D}: "Lucent (AT&T)",
+	{1, 0x9E}: "Exel",
+	{1, 0x1F}: "Atmel",
+	{1, 0x20}: "STMicroelectronics",
+	{1, 0xA1}: "Lattice Semi.",
+	{1, 0xA2}: "NCR",
+	{1, 0x23}: "Wafer Scale Integration",
+	{1, 0xA4}: "IBM",
+	{1, 0x25}: "Tristar",
+	{1, 0x26}: "Visic",
+	{1, 0xA7}: "Intl. CMOS Technology",
+	{1, 0xA8}: "SSSI",
+	{1, 0x29}: "Microchip Technology",
+	{1, 0x2A}: "Ricoh Ltd",
+	{1, 0xAB}: "VLSI",
+	{1, 0x2C}: "Micron Technology",
+	{1, 0xAD}: "SK Hynix",
+	{1, 0xAE}: "OKI Semiconductor",
+	{1, 0x2F}: "ACTEL",
+	{1, 0xB0}: "Sharp",
+	{1, 0x31}: "Catalyst",
+	{1, 0x32}: "Panasonic",
+	{1, 0xB3}: "IDT",
+	{1, 0x34}: "Cypress",
+	{1, 0xB5}: "DEC",
+	{1, 0xB6}: "LSI Logic",
+	{1, 0x37}: "Zarlink (Plessey)",
+	{1, 0x38}: "UTMC",
+	{1, 0xB9}: "Thinking Machine",
+	{1, 0xBA}: "Thomson CSF",
+	{1, 0x3B}: "Integrated CMOS (Vertex)",
+	{1, 0xBC}: "Honeywell",
+	{1, 0x3D}: "Tektronix",
+	{1, 0x3E}: "Oracle Corporation",
+	{1, 0xBF}: "Silicon Storage Technology",
+	{1, 0x40}: "ProMos/Mosel Vitelic",
+	{1, 0xC1}: "Infineon (Siemens)",
+	{1, 0xC2}: "Macronix",
+	{1, 0x43}: "Xerox",
+	{1, 0xC4}: "Plus Logic",
+	{1, 0x45}: "Western Digital Technologies Inc",
+	{1, 0x46}: "Elan Circuit Tech.",
+	{1, 0xC7}: "European Silicon Str.",
+	{1, 0xC8}: "Apple Computer",
+	{1, 0x49}: "Xilinx",
+	{1, 0x4A}: "Compaq",
+	{1, 0xCB}: "Protocol Engines",
+	{1, 0x4C}: "SCI",
+	{1, 0xCD}: "Seiko Instruments",
+	{1, 0xCE}: "Samsung",
+	{1, 0x4F}: "I3 Design System",
+	{1, 0xD0}: "Klic",
+	{1, 0x51}: "Crosspoint Solutions",
+	{1, 0x52}: "Alliance Semiconductor",
+	{1, 0xD3}: "Tandem",
+	{1, 0x54}: "Hewlett-Packard",
+	{1, 0xD5}: "Integrated Silicon Solutions",
+	{1, 0xD6}: "Brooktree",
+	{1, 0x57}: "New Media",
+	{1, 0x58}: "MHS Electronic",
+	{1, 0xD9}: "Performance Semi.",
+	{1, 0xDA}: "Winbond Electronic",
+	{1, 0x5B}: "Kawasaki Steel",
+	{1, 0xDC}: "Bright Micro",
+	{1, 0x5D}: "TECMAR",
+	{1, 0x5E}: "Exar",
+	{1, 0xDF}: "PCMCIA",
+	{1, 0xE0}: "LG Semi (Goldstar)",
+	{1, 0x61}: "Northern Telecom",
+	{1, 0x62}: "Sanyo",
+	{1, 0xE3}: "Array Microsystems",
+	{1, 0x64}: "Crystal Semiconductor",
+	{1, 0xE5}: "Analog Devices",
+	{1, 0xE6}: "PMC-Sierra",
+	{1, 0x67}: "Asparix",
+	{1, 0x68}: "Convex Computer",
+	{1, 0xE9}: "Quality Semiconductor",
+	{1, 0xEA}: "Nimbus Technology",
+	{1, 0x6B}: "Transwitch",
+	{1, 0xEC}: "Micronas (ITT Intermetall)",
+	{1, 0x6D}: "Cannon",
+	{1, 0x6E}: "Altera",
+	{1, 0xEF}: "NEXCOM",
+	{1, 0x70}: "Qualcomm",
+	{1, 0xF1}: "Sony",
+	{1, 0xF2}: "Cray Research",
+	{1, 0x73}: "AMS(Austria Micro)",
+	{1, 0xF4}: "Vitesse",
+	{1, 0x75}: "Aster Electronics",
+	{1, 0x76}: "Bay Networks (Synoptic)",
+	{1, 0xF7}: "Zentrum/ZMD",
+	{1, 0xF8}: "TRW",
+	{1, 0x79}: "Thesys",
+	{1, 0x7A}: "Solbourne Computer",
+	{1, 0xFB}: "Allied-Signal",
+	{1, 0x7C}: "Dialog Semiconductor",
+	{1, 0xFD}: "Media Vision",
+	{1, 0xFE}: "Numonyx Corporation",
+	// Bank 2.
+	{2, 0x01}: "Cirrus Logic",
+	{2, 0x02}: "National Instruments",
+	{2, 0x83}: "ILC Data Device",
+	{2, 0x04}: "Alcatel Mietec",
+	{2, 0x85}: "Micro Linear",
+	{2, 0x86}: "Univ. of NC",
+	{2, 0x07}: "JTAG Technologies",
+	{2, 0x08}: "BAE Systems (Loral)",
+	{2, 0x89}: "Nchip",
+	{2, 0x8A}: "Galileo Tech",
+	{2, 0x0B}: "Bestlink Systems",
+	{2, 0x8C}: "Graychip",
+	{2, 0x0D}: "GENNUM",
+	{2, 0x0E}: "Imagination Technologies Limited",
+	{2, 0x8F}: "Robert Bosch",
+	{2, 0x10}: "Chip Express",
+	{2, 0x91}: "DATARAM",
+	{2, 0x92}: "United Microelectronics Corp",
+	{2, 0x13}: "TCSI",
+	{2, 0x94}: "Smart Modular",
+	{2, 0x15}: "Hughes Aircraft",
+	{2, 0x16}: "Lanstar Semiconductor",
+	{2, 0x97}: "Qlogic",
+	{2, 0x98}: "Kingston",
+	{2, 0x19}: "Music Semi",
+	{2, 0x1A}: "Ericsson Components",
+	{2, 0x9B}: "SpaSE",
+	{2, 0x1C}: "Eon Silicon Devices",
+	{2, 0x9D}: "Integrated Silicon Solution (ISSI)",
+	{2, 0x9E}: "DoD",
+	{2, 0x1F}: "Integ. Memories Tech.",
+	{2, 0x20}: "Corollary Inc",
+	{2, 0xA1}: "Dallas Semiconductor",
+	{2, 0xA2}: "Omnivision",
+	{2, 0x23}: "EIV(Switzerland)",
+	{2, 0xA4}: "Novatel Wireless",
+	{2, 0x25}: "Zarlink (Mitel)",
+	{2, 0x26}: "Clearpoint",
+	{2, 0xA7}: "Cabletron",
+	{2, 0xA8}: "STEC (Silicon Tech)",
+	{2, 0x29}: "Vanguard",
+	{2, 0x2A}: "Hagiwara Sys-Com",
+	{2, 0xAB}: "Vantis",
+	{2, 0x2C}: "Celestica",
+	{2, 0xAD}: "Century",
+	{2, 0xAE}: "Hal Computers",
+	{2, 0x2F}: "Rohm Company Ltd",
+	{2, 0xB0}: "Juniper Networks",
+	{2, 0x31}: "Libit Signal Processing",
+	{2, 0x32}: "Mushkin Enhanced Memory",
+	{2, 0xB3}: "Tundra Semiconductor",
+	{2, 0x34}: "Adaptec Inc",
+	{2, 0xB5}: "LightSpeed Semi.",
+	{2, 0xB6}: "ZSP Corp",
+	{2, 0x37}: "AMIC Technology",
+	{2, 0x38}: "Adobe Systems",
+	{2, 0xB9}: "Dynachip",
+	{2, 0xBA}: "PNY Technologies, Inc.",
+	{2, 0x3B}: "Newport Digital",
+	{2, 0xBC}: "MMC Networks",
+	{2, 0x3D}: "T Square",
+	{2, 0x3E}: "Seiko Epson",
+	{2, 0xBF}: "Broadcom",
+	{2, 0x40}: "Viking Components",
+	{2, 0xC1}: "V3 Semiconductor",
+	{2, 0xC2}: "Flextronics (Orbit Semiconductor)",
+	{2, 0x43}: "Suwa Electronics",
+	{2, 0xC4}: "Transmeta",
+	{2, 0x45}: "Micron CMS",
+	{2, 0x46}: "American Computer & Digital Components Inc",
+	{2, 0xC7}: "Enhance 3000 Inc",
+	{2, 0xC8}: "Tower Semiconductor",
+	{2, 0x49}: "CPU Design",
+	{2, 0x4A}: "Price Point",
+	{2, 0xCB}: "Maxim Integrated Product",
+	{2, 0x4C}: "Tellabs",
+	{2, 0xCD}: "Centaur Technology",
+	{2, 0xCE}: "Unigen Corporation",
+	{2, 0x4F}: "Transcend Information",
+	{2, 0xD0}: "Memory Card Technology",
+	{2, 0x51}: "CKD Corporation Ltd",
+	{2, 0x52}: "Capital Instruments, Inc.",
+	{2, 0xD3}: "Aica Kogyo, Ltd.",
+	{2, 0x54}: "Linvex Technology",
+	{2, 0xD5}: "MSC Vertriebs GmbH",
+	{2, 0xD6}: "AKM Company, Ltd.",
+	{2, 0x57}: "Dynamem, Inc.",
+	{2, 0x58}: "NERA ASA",
+	{2, 0xD9}: "GSI Technology",
+	{2, 0xDA}: "Dane-Elec (C Memory)",
+	{2, 0x5B}: "Acorn Computers",
+	{2, 0xDC}: "Lara Technology",
+	{2, 0x5D}: "Oak Technology, Inc.",
+	{2, 0x5E}: "Itec Memory",
+	{2, 0xDF}: "Tanisys Technology",
+	{2, 0xE0}: "Truevision",
+	{2, 0x61}: "Wintec Industries",
+	{2, 0x62}: "Super PC Memory",
+	{2, 0xE3}: "MGV Memory",
+	{2, 0x64}: "Galvantech",
+	{2, 0xE5}: "Gadzoox Networks",
+	{2, 0xE6}: "Multi Dimensional Cons.",
+	{2, 0x67}: "GateField",
+	{2, 0x68}: "Integrated Memory System",
+	{2, 0xE9}: "Triscend",
+	{2, 0xEA}: "XaQti",
+	{2, 0x6B}: "Goldenram",
+	{2, 0xEC}: "Clear Logic",
+	{2, 0x6D}: "Cimaron Communications",
+	{2, 0x6E}: "Nippon Steel Semi. Corp.",
+	{2, 0xEF}: "Advantage Memory",
+	{2, 0x70}: "AMCC",
+	{2, 0xF1}: "LeCroy",
+	{2, 0xF2}: "Yamaha Corporation",
+	{2, 0x73}: "Digital Microwave",
+	{2, 0xF4}: "NetLogic Microsystems",
+	{2, 0x75}: "MIMOS Semiconductor",
+	{2, 0x76}: "Advanced Fibre",
+	{2, 0xF7}: "BF Goodrich Data.",
+	{2, 0xF8}: "Epigram",
+	{2, 0x79}: "Acbel Polytech Inc",
+	{2, 0x7A}: "Apacer Technology",
+	{2, 0xFB}: "Admor Memory",
+	{2, 0x7C}: "FOXCONN",
+	{2, 0xFD}: "Quadratics Superconductor",
+	{2, 0xFE}: "3COM",
+	// Bank 3.
+	{3, 0x01}: "Camintonn",
+	{3, 0x02}: "ISOA Incorporated",
+	{3, 0x83}: "Agate Semiconductor",
+	{3, 0x04}: "ADMtek Incorporated",
+	{3, 0x85}: "HYPERTEC",
+	{3, 0x86}: "Adhoc Technologies",
+	{3, 0x07}: "MOSAID Technologies",
+	{3, 0x08}: "Ardent Technologies",
+	{3, 0x89}: "Switchcore",
+	{3, 0x8A}: "Cisco Systems Inc",
+	{3, 0x0B}: "Allayer Technologies",
+	{3, 0x8C}: "WorkX AG (Wichman)",
+	{3, 0x0D}: "Oasis Semiconductor",
+	{3, 0x0E}: "Novanet Semiconductor",
+	{3, 0x8F}: "E-M Solutions",
+	{3, 0x10}: "Power General",
+	{3, 0x91}: "Advanced Hardware Arch.",
+	{3, 0x92}: "Inova Semiconductors GmbH",
+	{3, 0x13}: "Telocity",
+	{3, 0x94}: "Delkin Devices",
+	{3, 0x15}: "Symagery Microsystems",
+	{3, 0x16}: "C-Port Corporation",
+	{3, 0x97}: "SiberCore Technologies",
+	{3, 0x98}: "Southland Microsystems",
+	{3, 0x19}: "Malleable Technologies",
+	{3, 0x1A}: "Kendin Communications",
+	{3, 0x9B}: "Great Technology Microcomputer",
+	{3, 0x1C}: "Sanmina Corporation",
+	{3, 0x9D}: "HADCO Corporation",
+	{3, 0x9E}: "Corsair",
+	{3, 0x1F}: "Actrans System Inc",
+	{3, 0x20}: "ALPHA Technologies",
+	{3, 0xA1}: "Silicon Laboratories Inc (Cygnal)",
+	{3, 0xA2}: "Artesyn Technologies",
+	{3, 0x23}: "Align Manufacturing",
+	{3, 0xA4}: "Peregrine Semiconductor",
+	{3, 0x25}: "Chameleon Systems",
+	{3, 0x26}: "Aplus Flash Technology",
+	{3, 0xA7}: "MIPS Technologies",
+	{3, 0xA8}: "Chrysalis ITS",
+	{3, 0x29}: "ADTEC Corporation",
+	{3, 0x2A}: "Kentron Technologies",
+	{3, 0xAB}: "Win Technologies",
+	{3, 0x2C}: "Tezzaron Semiconductor",
+	{3, 0xAD}: "Extreme Packet Devices",
+	{3, 0xAE}: "RF Micro Devices",
+	{3, 0x2F}: "Siemens AG",
+	{3, 0xB0}: "Sarnoff Corporation",
+	{3, 0x31}: "Itautec SA",
+	{3, 0x32}: "Radiata Inc",
+	{3, 0xB3}: "Benchmark Elect. (AVEX)",
+	{3, 0x34}: "Legend",
+	{3, 0xB5}: "SpecTek Incorporated",
+	{3, 0xB6}: "Hi/fn",
+	{3, 0x37}: "Enikia Incorporated",
+	{3, 0x38}: "SwitchOn Networks",
+	{3, 0xB9}: "AANetcom Incorporated",
+	{3, 0xBA}: "Micro Memory Bank",
+	{3, 0x3B}: "ESS Technology",
+	{3, 0xBC}: "Virata Corporation",
+	{3, 0x3D}: "Excess Bandwidth",
+	{3, 0x3E}: "West Bay Semiconductor",
+	{3, 0xBF}: "DSP Group",
+	{3, 0x40}: "Newport Communications",
+	{3, 0xC1}: "Chip2Chip Incorporated",
+	{3, 0xC2}: "Phobos Corporation",
+	{3, 0x43}: "Intellitech Corporation",
+	{3, 0xC4}: "Nordic VLSI ASA",
+	{3, 0x45}: "Ishoni Networks",
+	{3, 0x46}: "Silicon Spice",
+	{3, 0xC7}: "Alchemy Semiconductor",
+	{3, 0xC8}: "Agilent Technologies",
+	{3, 0x49}: "Centillium Communications",
+	{3, 0x4A}: "W.L. Gore",
+	{3, 0xCB}: "HanBit Electronics",
+	{3, 0x4C}: "GlobeSpan",
+	{3, 0xCD}: "Element 14",
+	{3, 0xCE}: "Pycon",
+	{3, 0x4F}: "Saifun Semiconductors",
+	{3, 0xD0}: "Sibyte, Incorporated",
+	{3, 0x51}: "MetaLink Technologies",
+	{3, 0x52}: "Feiya Technology",
+	{3, 0xD3}: "I & C Technology",
+	{3, 0x54}: "Shikatronics",
+	{3, 0xD5}: "Elektrobit",
+	{3, 0xD6}: "Megic",
+	{3, 0x57}: "Com-Tier",
+	{3, 0x58}: "Malaysia Micro Solutions",
+	{3, 0xD9}: "Hyperchip",
+	{3, 0xDA}: "Gemstone Communications",
+	{3, 0x5B}: "Anadigm (Anadyne)",
+	{3, 0xDC}: "3ParData",
+	{3, 0x5D}: "Mellanox Technologies",
+	{3, 0x5E}: "Tenx Technologies",
+	{3, 0xDF}: "Helix AG",
+	{3, 0xE0}: "Domosys",
+	{3, 0x61}: "Skyup Technology",
+	{3, 0x62}: "HiNT Corporation",
+	{3, 0xE3}: "Chiaro",
+	{3, 0x64}: "MDT Technologies GmbH",
+	{3, 0xE5}: "Exbit Materials",
+	{3, 0xE6}: "Integrated Technology Express",
+	{3, 0x67}: "AVED Memory",
+	{3, 0x68}: "Legerity",
+	{3, 0xE9}: "Jasmine Networks",
+	{3, 0xEA}: "Caspian Networks",
+	{3, 0x6B}: "nCUBE",
+	{3, 0xEC}: "Silicon Access Networks",
+	{3, 0x6D}: "FDK Corporation",
+	{3, 0x6E}: "High Bandwidth Access",
+	{3, 0xEF}: "MultiLink Technology",
+	{3, 0x70}: "BRECIS",
+	{3, 0xF1}: "World Wide Packets",
+	{3, 0xF2}: "APW",
+	{3, 0x73}: "Chicory Systems",
+	{3, 0xF4}: "Xstream Logic",
+	{3, 0x75}: "Fast-Chip",
+	{3, 0x76}: "Zucotto Wireless",
+	{3, 0xF7}: "Realchip",
+	{3, 0xF8}: "Galaxy Power",
+	{3, 0x79}: "eSilicon",
+	{3, 0x7A}: "Morphics Technology",
+	{3, 0xFB}: "Accelerant Networks",
+	{3, 0x7C}: "Silicon Wave",
+	{3, 0xFD}: "SandCraft",
+	{3, 0xFE}: "Elpida",
+	// Bank 4.
+	{4, 0x01}: "Solectron",
+	{4, 0x02}: "Optosys Technologies",
+	{4, 0x83}: "Buffalo (Formerly Melco)",
+	{4, 0x04}: "TriMedia Technologies",
+	{4, 0x85}: "Cyan Technologies",
+	{4, 0x86}: "Global Locate",
+	{4, 0x07}: "Optillion",
+	{4, 0x08}: "Terago Communications",
+	{4, 0x89}: "Ikanos Communications",
+	{4, 0x8A}: "Princeton Technology",
+	{4, 0x0B}: "Nanya Technology",
+	{4, 0x8C}: "Elite Flash Storage",
+	{4, 0x0D}: "Mysticom",
+	{4, 0x0E}: "LightSand Communications",
+	{4, 0x8F}: "ATI Technologies",
+	{4, 0x10}: "Agere Systems",
+	{4, 0x91}: "NeoMagic",
+	{4, 0x92}: "AuroraNetics",
+	{4, 0x13}: "Golden Empire",
+	{4, 0x94}: "Mushkin",
+	{4, 0x15}: "Tioga Technologies",
+	{4, 0x16}: "Netlist",
+	{4, 0x97}: "TeraLogic",
+	{4, 0x98}: "Cicada Semiconductor",
+	{4, 0x19}: "Centon Electronics",
+	{4, 0x1A}: "Tyco Electronics",
+	{4, 0x9B}: "Magis Works",
+	{4, 0x1C}: "Zettacom",
+	{4, 0x9D}: "Cogency Semiconductor",
+	{4, 0x9E}: "Chipcon AS",
+	{4, 0x1F}: "Aspex Technology",
+	{4, 0x20}: "F5 Networks",
+	{4, 0xA1}: "Programmable Silicon Solutions",
+	{4, 0xA2}: "ChipWrights",
+	{4, 0x23}: "Acorn Networks",
+	{4, 0xA4}: "Quicklogic",
+	{4, 0x25}: "Kingmax Semiconductor",
+	{4, 0x26}: "BOPS",
+	{4, 0xA7}: "Flasys",
+	{4, 0xA8}: "BitBlitz Communications",
+	{4, 0x29}: "eMemory Technology",
+	{4, 0x2A}: "Procket Networks",
+	{4, 0xAB}: "Purple Ray",
+	{4, 0x2C}: "Trebia Networks",
+	{4, 0xAD}: "Delta Electronics",
+	{4, 0xAE}: "Onex Communications",
+	{4, 0x2F}: "Ample Communications",
+	{4, 0xB0}: "Memory Experts Intl",
+	{4, 0x31}: "Astute Networks",
+	{4, 0x32}: "Azanda Network Devices",
+	{4, 0xB3}: "Dibcom",
+	{4, 0x34}: "Tekmos",
+	{4, 0xB5}: "API NetWorks",
+	{4, 0xB6}: "Bay Microsystems",
+	{4, 0x37}: "Firecron Ltd",
+	{4, 0x38}: "Resonext Communications",
+	{4, 0xB9}: "Tachys Technologies",
+	{4, 0xBA}: "Equator Technology",
+	{4, 0x3B}: "Concept Computer",
+	{4, 0xBC}: "SILCOM",
+	{4, 0x3D}: "3Dlabs",
+	{4, 0x3E}: "c't Magazine",
+	{4, 0xBF}: "Sanera Systems",
+	{4, 0x40}: "Silicon Packets",
+	{4, 0xC1}: "Viasystems Group",
+	{4, 0xC2}: "Simtek",
+	{4, 0x43}: "Semicon Devices Singapore",
+	{4, 0xC4}: "Satron Handelsges",
+	{4, 0x45}: "Improv Systems",
+	{4, 0x46}: "INDUSYS GmbH",
+	{4, 0xC7}: "Corrent",
+	{4, 0xC8}: "Infrant Technologies",
+	{4, 0x49}: "Ritek Corp",
+	{4, 0x4A}: "empowerTel Networks",
+	{4, 0xCB}: "Hypertec",
+	{4, 0x4C}: "Cavium Networks",
+	{4, 0xCD}: "PLX Technology",
+	{4, 0xCE}: "Massana Design",
+	{4, 0x4F}: "Intrinsity",
+	{4, 0xD0}: "Valence Semiconductor",
+	{4, 0x51}: "Terawave Communications",
+	{4, 0x52}: "IceFyre Semiconductor",
+	{4, 0xD3}: "Primarion",
+	{4, 0x54}: "Picochip Designs Ltd",
+	{4, 0xD5}: "Silverback Systems",
+	{4, 0xD6}: "Jade Star Technologies",
+	{4, 0x57}: "Pijnenburg Securealink",
+	{4, 0x58}: "takeMS - Ultron AG",
+	{4, 0xD9}: "Cambridge Silicon Radio",
+	{4, 0xDA}: "Swissbit",
+	{4, 0x5B}: "Nazomi Communications",
+	{4, 0xDC}: "eWave System",
+	{4, 0x5D}: "Rockwell Collins",
+	{4, 0x5E}: "Picocel Co Ltd (Paion)",
+	{4, 0xDF}: "Alphamosaic Ltd",
+	{4, 0xE0}: "Sandburst",
+	{4, 0x61}: "SiCon Video",
+	{4, 0x62}: "NanoAmp Solutions",
+	{4, 0xE3}: "Ericsson Technology",
+	{4, 0x64}: "PrairieComm",
+	{4, 0xE5}: "Mitac International",
+	{4, 0xE6}: "Layer N Networks",
+	{4, 0x67}: "MtekVision (Atsana)",
+	{4, 0x68}: "Allegro Networks",
+	{4, 0xE9}: "Marvell Semiconductors",
+	{4, 0xEA}: "Netergy Microelectronic",
+	{4, 0x6B}: "NVIDIA",
+	{4, 0xEC}: "Internet Machines",
+	{4, 0x6D}: "Memorysolution GmbH",
+	{4, 0x6E}: "Litchfield Communication",
+	{4, 0xEF}: "Accton Technology",
+	{4, 0x70}: "Teradiant Networks",
+	{4, 0xF1}: "Scaleo Chip",
+	{4, 0xF2}: "Cortina Systems",
+	{4, 0x73}: "RAM Components",
+	{4, 0xF4}: "Raqia Networks",
+	{4, 0x75}: "ClearSpeed",
+	{4, 0x76}: "Matsushita Battery",
+	{4, 0xF7}: "Xelerated",
+	{4, 0xF8}: "SimpleTech",
+	{4, 0x79}: "Utron Technology",
+	{4, 0x7A}: "Astec International",
+	{4, 0xFB}: "AVM gmbH",
+	{4, 0x7C}: "Redux Communications",
+	{4, 0xFD}: "Dot Hill Systems",
+	{4, 0xFE}: "TeraChip",
+	// Bank 5.
+	{5, 0x01}: "T-RAM Incorporated",
+	{5, 0x02}: "Innovics Wireless",
+	{5, 0x83}: "Teknovus",
+	{5, 0x04}: "KeyEye Communications",
+	{5, 0x85}: "Runcom Technologies",
+	{5, 0x86}: "RedSwitch",
+	{5, 0x07}: "Dotcast",
+	{5, 0x08}: "Silicon Mountain Memory",
+	{5, 0x89}: "Signia Technologies",
+	{5, 0x8A}: "Pixim",
+	{5, 0x0B}: "Galazar Networks",
+	{5, 0x8C}: "White Electronic Designs",
+	{5, 0x0D}: "Patriot Scientific",
+	{5, 0x0E}: "Neoaxiom Corporation",
+	{5, 0x8F}: "3Y Power Technology",
+	{5, 0x10}: "Scaleo Chip",
+	{5, 0x91}: "Potentia Power Systems",
+	{5, 0x92}: "C-guys Incorporated",
+	{5, 0x13}: "Digital Communications Technology Inc",
+	{5, 0x94}: "Silicon-Based Technology",
+	{5, 0x15}: "Fulcrum Microsystems",
+	{5, 0x16}: "Positivo Informatica Ltd",
+	{5, 0x97}: "XIOtech Corporation",
+	{5, 0x98}: "PortalPlayer",
+	{5, 0x19}: "Zhiying Software",
+	{5, 0x1A}: "ParkerVision, Inc.",
+	{5, 0x9B}: "Phonex Broadband",
+	{5, 0x1C}: "Skyworks Solutions",
+	{5, 0x9D}: "Entropic Communications",
+	{5, 0x9E}: "I'M Intelligent Memory Ltd.",
+	{5, 0x1F}: "Zensys A/S",
+	{5, 0x20}: "Legend Silicon Corp.",
+	{5, 0xA1}: "Sci-worx GmbH",
+	{5, 0xA2}: "SMSC (Standard Microsystems)",
+	{5, 0x23}: "Renesas Electronics",
+	{5, 0xA4}: "Raza Microelectronics",
+	{5, 0x25}: "Phyworks",
+	{5, 0x26}: "MediaTek",
+	{5, 0xA7}: "Non-cents Productions",
+	{5, 0xA8}: "US Modular",
+	{5, 0x29}: "Wintegra Ltd.",
+	{5, 0x2A}: "Mathstar",
+	{5, 0xAB}: "StarCore",
+	{5, 0x2C}: "Oplus Technologies",
+	{5, 0xAD}: "Mindspeed",
+	{5, 0xAE}: "Just Young Computer",
+	{5, 0x2F}: "Radia Communications",
+	{5, 0xB0}: "OCZ",
+	{5, 0x31}: "Emuzed",
+	{5, 0x32}: "LOGIC Devices",
+	{5, 0xB3}: "Inphi Corporation",
+	{5, 0x34}: "Quake Technologies",
+	{5, 0xB5}: "Vixel",
+	{5, 0xB6}: "SolusTek",
+	{5, 0x37}: "Kongsberg Maritime",
+	{5, 0x38}: "Faraday Technology",
+	{5, 0xB9}: "Altium Ltd.",
+	{5, 0xBA}: "Insyte",
+	{5, 0x3B}: "ARM Ltd",
+	{5, 0xBC}: "DigiVision",
+	{5, 0x3D}: "Vativ Technologies",
+	{5, 0x3E}: "Endicott Interconnect Technologies",
+	{5, 0xBF}: "Pericom",
+	{5, 0x40}: "Bandspeed",
+	{5, 0xC1}: "LeWiz Communications",
+	{5, 0xC2}: "CPU Technology",
+	{5, 0x43}: "Ramaxel Technology",
+	{5, 0xC4}: "DSP Group",
+	{5, 0x45}: "Axis Communications",
+	{5, 0x46}: "Legacy Electronics",
+	{5, 0xC7}: "Chrontel",
+	{5, 0xC8}: "Powerchip Semiconductor",
+	{5, 0x49}: "MobilEye Technologies",
+	{5, 0x4A}: "Excel Semiconductor",
+	{5, 0xCB}: "A-DATA Technology",
+	{5, 0x4C}: "VirtualDigm",
+	{5, 0xCD}: "G Skill Intl",
+	{5, 0xCE}: "Quanta Computer",
+	{5, 0x4F}: "Yield Microelectronics",
+	{5, 0xD0}: "Afa Technologies",
+	{5, 0x51}: "KINGBOX Technology Co. Ltd.",
+	{5, 0x52}: "Ceva",
+	{5, 0xD3}: "iStor Networks",
+	{5, 0x54}: "Advance Modules",
+	{5, 0xD5}: "Microsoft",
+	{5, 0xD6}: "Open-Silicon",
+	{5, 0x57}: "Goal Semiconductor",
+	{5, 0x58}: "ARC International",
+	{5, 0xD9}: "Simmtec",
+	{5, 0xDA}: "Metanoia",
+	{5, 0x5B}: "Key Stream",
+	{5, 0xDC}: "Lowrance Electronics",
+	{5, 0x5D}: "Adimos",
+	{5, 0x5E}: "SiGe Semiconductor",
+	{5, 0xDF}: "Fodus Communications",
+	{5, 0xE0}: "Credence Systems Corp.",
+	{5, 0x61}: "Genesis Microchip Inc.",
+	{5, 0x62}: "Vihana, Inc.",
+	{5, 0xE3}: "WIS Technologies",
+	{5, 0x64}: "GateChange Technologies",
+	{5, 0xE5}: "High Density Devices AS",
+	{5, 0xE6}: "Synopsys",
+	{5, 0x67}: "Gigaram",
+	{5, 0x68}: "Enigma Semiconductor Inc.",
+	{5, 0xE9}: "Century Micro Inc.",
+	{5, 0xEA}: "Icera Semiconductor",
+	{5, 0x6B}: "Mediaworks Integrated Systems",
+	{5, 0xEC}: "O'Neil Product Development",
+	{5, 0x6D}: "Supreme Top Technology Ltd.",
+	{5, 0x6E}: "MicroDisplay Corporation",
+	{5, 0xEF}: "Team Group Inc",
+	{5, 0x70}: "Sinett Corporation",
+	{5, 0xF1}: "Toshiba Corporation",
+	{5, 0xF2}: "Tensilica",
+	{5, 0x73}: "SiRF Technology",
+	{5, 0xF4}: "Bacoc Inc.",
+	{5, 0x75}: "SMaL Camera Technologies",
+	{5, 0x76}: "Thomson SC",
+	{5, 0xF7}: "Airgo Networks",
+	{5, 0xF8}: "Wisair Ltd.",
+	{5, 0x79}: "SigmaTel",
+	{5, 0x7A}: "Arkados",
+	{5, 0xFB}: "Compete IT gmbH Co. KG",
+	{5, 0x7C}: "Eudar Technology Inc.",
+	{5, 0xFD}: "Focus Enhancements",
+	{5, 0xFE}: "Xyratex",
+	// Bank 6.
+	{6, 0x01}: "Specular Networks",
+	{6, 0x02}: "Patriot Memory (PDP Systems)",
+	{6, 0x83}: "U-Chip Technology Corp.",
+	{6, 0x04}: "Silicon Optix",
+	{6, 0x85}: "Greenfield Networks",
+	{6, 0x86}: "CompuRAM GmbH",
+	{6, 0x07}: "Stargen, Inc.",
+	{6, 0x08}: "NetCell Corporation",
+	{6, 0x89}: "Excalibrus Technologies Ltd",
+	{6, 0x8A}: "SCM Microsystems",
+	{6, 0x0B}: "Xsigo Systems, Inc.",
+	{6, 0x8C}: "CHIPS & Systems Inc",
+	{6, 0x0D}: "Tier 1 Multichip Solutions",
+	{6, 0x0E}: "CWRL Labs",
+	{6, 0x8F}: "Teradici",
+	{6, 0x10}: "Gigaram, Inc.",
+	{6, 0x91}: "g2 Microsystems",
+	{6, 0x92}: "PowerFlash Semiconductor",
+	{6, 0x13}: "P.A. Semi, Inc.",
+	{6, 0x94}: "NovaTech Solutions, S.A.",
+	{6, 0x15}: "c2 Microsystems, Inc.",
+	{6, 0x16}: "Level5 Networks",
+	{6, 0x97}: "COS Memory AG",
+	{6, 0x98}: "Innovasic Semiconductor",
+	{6, 0x19}: "02IC Co. Ltd",
+	{6, 0x1A}: "Tabula, Inc.",
+	{6, 0x9B}: "Crucial Technology",
+	{6, 0x1C}: "Chelsio Communications",
+	{6, 0x9D}: "Solarflare Communications",
+	{6, 0x9E}: "Xambala Inc.",
+	{6, 0x1F}: "EADS Astrium",
+	{6, 0x20}: "Terra Semiconductor, Inc.",
+	{6, 0xA1}: "Imaging Works, Inc.",
+	{6, 0xA2}: "Astute Networks, Inc.",
+	{6, 0x23}: "Tzero",
+	{6, 0xA4}: "Emulex",
+	{6, 0x25}: "Power-One",
+	{6, 0x26}: "Pulse~LINK Inc.",
+	{6, 0xA7}: "Hon Hai Precision Industry",
+	{6, 0xA8}: "White Rock Networks Inc.",
+	{6, 0x29}: "Telegent Systems USA, Inc.",
+	{6, 0x2A}: "Atrua Technologies, Inc.",
+	{6, 0xAB}: "Acbel Polytech Inc.",
+	{6, 0x2C}: "eRide Inc.",
+	{6, 0xAD}: "ULi Electronics Inc.",
+	{6, 0xAE}: "Magnum Semiconductor Inc.",
+	{6, 0x2F}: "neoOne Technology, Inc.",
+	{6, 0xB0}: "Connex Technology, Inc.",
+	{6, 0x31}: "Stream Processors, Inc.",
+	{6, 0x32}: "Focus Enhancements",
+	{6, 0xB3}: "Telecis Wireless, Inc.",
+	{6, 0x34}: "uNav Microelectronics",
+	{6, 0xB5}: "Tarari, Inc.",
+	{6, 0xB6}: "Ambric, Inc.",
+	{6, 0x37}: "Newport Media, Inc.",
+	{6, 0x38}: "VMTS",
+	{6, 0xB9}: "Enuclia Semiconductor, Inc.",
+	{6, 0xBA}: "Virtium Technology Inc.",
+	{6, 0x3B}: "Solid State System Co., Ltd.",
+	{6, 0xBC}: "Kian Tech LLC",
+	{6, 0x3D}: "Artimi",
+	{6, 0x3E}: "Power Quotient International",
+	{6, 0xBF}: "Avago Technologies",
+	{6, 0x40}: "ADTechnology",
+	{6, 0xC1}: "Sigma Designs",
+	{6, 0xC2}: "SiCortex, Inc.",
+	{6, 0x43}: "Ventura Technology Group",
+	{6, 0xC4}: "eASIC",
+	{6, 0x45}: "M.H.S. SAS",
+	{6, 0x46}: "Micro Star International",
+	{6, 0xC7}: "Rapport Inc.",
+	{6, 0xC8}: "Makway International",
+	{6, 0x49}: "Broad Reach Engineering Co.",
+	{6, 0x4A}: "Semiconductor Mfg Intl Corp",
+	{6, 0xCB}: "SiConnect",
+	{6, 0x4C}: "FCI USA Inc.",
+	{6, 0xCD}: "Validity Sensors",
+	{6, 0xCE}: "Coney Technology Co. Ltd.",
+	{6, 0x4F}: "Spans Logic",
+	{6, 0xD0}: "Neterion Inc.",
+	{6, 0x51}: "Qimonda",
+	{6, 0x52}: "New Japan Radio Co. Ltd.",
+	{6, 0xD3}: "Velogix",
+	{6, 0x54}: "Montalvo Systems",
+	{6, 0xD5}: "iVivity Inc.",
+	{6, 0xD6}: "Walton Chaintech",
+	{6, 0x57}: "AENEON",
+	{6, 0x58}: "Lorom Industrial Co. Ltd.",
+	{6, 0xD9}: "Radiospire Networks",
+	{6, 0xDA}: "Sensio Technologies, Inc.",
+	{6, 0x5B}: "Nethra Imaging",
+	{6, 0xDC}: "Hexon Technology Pte Ltd",
+	{6, 0x5D}: "CompuStocx (CSX)",
+	{6, 0x5E}: "Methode Electronics, Inc.",
+	{6, 0xDF}: "Connect One Ltd.",
+	{6, 0xE0}: "Opulan Technologies",
+	{6, 0x61}: "Septentrio NV",
+	{6, 0x62}: "Goldenmars Technology Inc.",
+	{6, 0xE3}: "Kreton Corporation",
+	{6, 0x64}: "Cochlear Ltd.",
+	{6, 0xE5}: "Altair Semiconductor",
+	{6, 0xE6}: "NetEffect, Inc.",
+	{6, 0x67}: "Spansion, Inc.",
+	{6, 0x68}: "Taiwan Semiconductor Mfg",
+	{6, 0xE9}: "Emphany Systems Inc.",
+	{6, 0xEA}: "ApaceWave Technologies",
+	{6, 0x6B}: "Mobilygen Corporation",
+	{6, 0xEC}: "Tego",
+	{6, 0x6D}: "Cswitch Corporation",
+	{6, 0x6E}: "Haier (Beijing) IC Design Co.",
+	{6, 0xEF}: "MetaRAM",
+	{6, 0x70}: "Axel Electronics Co. Ltd.",
+	{6, 0xF1}: "Tilera Corporation",
+	{6, 0xF2}: "Aquantia",
+	{6, 0x73}: "Vivace Semiconductor",
+	{6, 0xF4}: "Redpine Signals",
+	{6, 0x75}: "Octalica",
+	{6, 0x76}: "InterDigital Communications",
+	{6, 0xF7}: "Avant Technology",
+	{6, 0xF8}: "Asrock, Inc.",
+	{6, 0x79}: "Availink",
+	{6, 0x7A}: "Quartics, Inc.",
+	{6, 0xFB}: "Element CXI",
+	{6, 0x7C}: "Innovaciones Microelectronicas",
+	{6, 0xFD}: "VeriSilicon Microelectronics",
+	{6, 0xFE}: "W5 Networks",
+	// Bank 7.
+	{7, 0x01}: "MOVEKING",
+	{7, 0x02}: "Mavrix Technology, Inc.",
+	{7, 0x83}: "CellGuide Ltd.",
+	{7, 0x04}: "Faraday Technology",
+	{7, 0x85}: "Diablo Technologies, Inc.",
+	{7, 0x86}: "Jennic",
+	{7, 0x07}: "Octasic",
+	{7, 0x08}: "Molex Incorporated",
+	{7, 0x89}: "3Leaf Networks",
+	{7, 0x8A}: "Bright Micron Technology",
+	{7, 0x0B}: "Netxen",
+	{7, 0x8C}: "NextWave Broadband Inc.",
+	{7, 0x0D}: "DisplayLink",
+	{7, 0x0E}: "ZMOS Technology",
+	{7, 0x8F}: "Tec-Hill",
+	{7, 0x10}: "Multigig, Inc.",
+	{7, 0x91}: "Amimon",
+	{7, 0x92}: "Euphonic Technologies, Inc.",
+	{7, 0x13}: "BRN Phoenix",
+	{7, 0x94}: "InSilica",
+	{7, 0x15}: "Ember Corporation",
+	{7, 0x16}: "Avexir Technologies Corporation",
+	{7, 0x97}: "Echelon Corporation",
+	{7, 0x98}: "Edgewater Computer Systems",
+	{7, 0x19}: "XMOS Semiconductor Ltd.",
+	{7, 0x1A}: "GENUSION, Inc.",
+	{7, 0x9B}: "Memory Corp NV",
+	{7, 0x1C}: "SiliconBlue Technologies",
+	{7, 0x9D}: "Rambus Inc.",
+	{7, 0x9E}: "Andes Technology Corporation",
+	{7, 0x1F}: "Coronis Systems",
+	{7, 0x20}: "Achronix Semiconductor",
+	{7, 0xA1}: "Siano Mobile Silicon Ltd.",
+	{7, 0xA2}: "Semtech Corporation",
+	{7, 0x23}: "Pixelworks Inc.",
+	{7, 0xA4}: "Gaisler Research AB",
+	{7, 0x25}: "Teranetics",
+	{7, 0x26}: "Toppan Printing Co. Ltd.",
+	{7, 0xA7}: "Kingxcon",
+	{7, 0xA8}: "Silicon Integrated Systems",
+	{7, 0x29}: "I-O Data Device, Inc.",
+	{7, 0x2A}: "NDS Americas Inc.",
+	{7, 0xAB}: "Solomon Systech Limited",
+	{7, 0x2C}: "On Demand Microelectronics",
+	{7, 0xAD}: "Amicus Wireless Inc.",
+	{7, 0xAE}: "SMARDTV SNC",
+	{7, 0x2F}: "Comsys Communication Ltd.",
+	{7, 0xB0}: "Movidia Ltd.",
+	{7, 0x31}: "Javad GNSS, Inc.",
+	{7, 0x32}: "Montage Technology Group",
+	{7, 0xB3}: "Trident Microsystems",
+	{7, 0x34}: "Super Talent",
+	{7, 0xB5}: "Optichron, Inc.",
+	{7, 0xB6}: "Future Waves UK Ltd.",
+	{7, 0x37}: "SiBEAM, Inc.",
+	{7, 0x38}: "InicoreInc.",
+	{7, 0xB9}: "Virident Systems",
+	{7, 0xBA}: "M2000, Inc.",
+	{7, 0x3B}: "ZeroG Wireless, Inc.",
+	{7, 0xBC}: "Gingle Technology Co. Ltd.",
+	{7, 0x3D}: "Space Micro Inc.",
+	{7, 0x3E}: "Wilocity",
+	{7, 0xBF}: "Novafora, Inc.",
+	{7, 0x40}: "iKoa Corporation",
+	{7, 0xC1}: "ASint Technology",
+	{7, 0xC2}: "Ramtron",
+	{7, 0x43}: "Plato Networks Inc.",
+	{7, 0xC4}: "IPtronics AS",
+	{7, 0x45}: "Infinite-Memories",
+	{7, 0x46}: "Parade Technologies Inc.",
+	{7, 0xC7}: "Dune Networks",
+	{7, 0xC8}: "GigaDevice Semiconductor",
+	{7, 0x49}: "Modu Ltd.",
+	{7, 0x4A}: "CEITEC",
+	{7, 0xCB}: "Northrop Grumman",
+	{7, 0x4C}: "XRONET Corporation",
+	{7, 0xCD}: "Sicon Semiconductor AB",
+	{7, 0xCE}: "Atla Electronics Co. Ltd.",
+	{7, 0x4F}: "TOPRAM Technology",
+	{7, 0xD0}: "Silego Technology Inc.",
+	{7, 0x51}: "Kinglife",
+	{7, 0x52}: "Ability Industries Ltd.",
+	{7, 0xD3}: "Silicon Power Computer & Communications",
+	{7, 0x54}: "Augusta Technology, Inc.",
+	{7, 0xD5}: "Nantronics Semiconductors",
+	{7, 0xD6}: "Hilscher Gesellschaft",
+	{7, 0x57}: "Quixant Ltd.",
+	{7, 0x58}: "Percello Ltd.",
+	{7, 0xD9}: "NextIO Inc.",
+	{7, 0xDA}: "Scanimetrics Inc.",
+	{7, 0x5B}: "FS-Semi Company Ltd.",
+	{7, 0xDC}: "Infinera Corporation",
+	{7, 0x5D}: "SandForce Inc.",
+	{7, 0x5E}: "Lexar Media",
+	{7, 0xDF}: "Teradyne Inc.",
+	{7, 0xE0}: "Memory Exchange Corp.",
+	{7, 0x61}: "Suzhou Smartek Electronics",
+	{7, 0x62}: "Avantium Corporation",
+	{7, 0xE3}: "ATP Electronics Inc.",
+	{7, 0x64}: "Valens Semiconductor Ltd",
+	{7, 0xE5}: "Agate Logic, Inc.",
+	{7, 0xE6}: "Netronome",
+	{7, 0x67}: "Zenverge, Inc.",
+	{7, 0x68}: "N-trig Ltd",
+	{7, 0xE9}: "SanMax Technologies Inc.",
+	{7, 0xEA}: "Contour Semiconductor Inc.",
+	{7, 0x6B}: "TwinMOS",
+	{7, 0xEC}: "Silicon Systems, Inc.",
+	{7, 0x6D}: "V-Color Technology Inc.",
+	{7, 0x6E}: "Certicom Corporation",
+	{7, 0xEF}: "JSC ICC Milandr",
+	{7, 0x70}: "PhotoFast Global Inc.",
+	{7, 0xF1}: "InnoDisk Corporation",
+	{7, 0xF2}: "Muscle Power",
+	{7, 0x73}: "Energy Micro",
+	{7, 0xF4}: "Innofidei",
+	{7, 0x75}: "CopperGate Communications",
+	{7, 0x76}: "Holtek Semiconductor Inc.",
+	{7, 0xF7}: "Myson Century, Inc.",
+	{7, 0xF8}: "FIDELIX",
+	{7, 0x79}: "Red Digital Cinema",
+	{7, 0x7A}: "Densbits Technology",
+	{7, 0xFB}: "Zempro",
+	{7, 0x7C}: "MoSys",
+	{7, 0xFD}: "Provigent",
+	{7, 0xFE}: "Triad Semiconductor, Inc.",
+	// Bank 8.
+	{8, 0x01}: "Siklu Communication Ltd.",
+	{8, 0x02}: "A Force Manufacturing Ltd.",
+	{8, 0x83}: "Strontium",
+	{8, 0x04}: "ALi Corp (Abilis Systems)",
+	{8, 0x85}: "Siglead, Inc.",
+	{8, 0x86}: "Ubicom, Inc.",
+	{8, 0x07}: "Unifosa Corporation",
+	{8, 0x08}: "Stretch, Inc.",
+	{8, 0x89}: "Lantiq Deutschland GmbH",
+	{8, 0x8A}: "Visipro.",
+	{8, 0x0B}: "EKMemory",
+	{8, 0x8C}: "Microelectronics Institute ZTE",
+	{8, 0x0D}: "u-blox AG",
+	{8, 0x0E}: "Carry Technology Co. Ltd.",
+	{8, 0x8F}: "Nokia",
+	{8, 0x10}: "King Tiger Technology",
+	{8, 0x91}: "Sierra Wireless",
+	{8, 0x92}: "HT Micron",
+	{8, 0x13}: "Albatron Technology Co. Ltd.",
+	{8, 0x94}: "Leica Geosystems AG",
+	{8, 0x15}: "BroadLight",
+	{8, 0x16}: "AEXEA",
+	{8, 0x97}: "ClariPhy Communications, Inc.",
+	{8, 0x98}: "Green Plug",
+	{8, 0x19}: "Design Art Networks",
+	{8, 0x1A}: "Mach Xtreme Technology Ltd.",
+	{8, 0x9B}: "ATO Solutions Co. Ltd.",
+	{8, 0x1C}: "Ramsta",
+	{8, 0x9D}: "Greenliant Systems, Ltd.",
+	{8, 0x9E}: "Teikon",
+	{8, 0x1F}: "Antec Hadron",
+	{8, 0x20}: "NavCom Technology, Inc.",
+	{8, 0xA1}: "Shanghai Fudan Microelectronics",
+	{8, 0xA2}: "Calxeda, Inc.",
+	{8, 0x23}: "JSC EDC Electronics",
+	{8, 0xA4}: "Kandit Technology Co. Ltd.",
+	{8, 0x25}: "Ramos Technology",
+	{8, 0x26}: "Goldenmars Technology",
+	{8, 0xA7}: "XeL Technology Inc.",
+	{8, 0xA8}: "Newzone Corporation",
+	{8, 0x29}: "ShenZhen MercyPower Tech",
+	{8, 0x2A}: "Nanjing Yihuo Technology",
+	{8, 0xAB}: "Nethra Imaging Inc.",
+	{8, 0x2C}: "SiTel Semiconductor BV",
+	{8, 0xAD}: "SolarEdge Technologies",
+	{8, 0xAE}: "Octopus",
+	{8, 0x2F}: "Vimicro Corporation",
+	{8, 0xB0}: "UANT Technology Co. Ltd.",
+	{8, 0x31}: "DiBcom",
+	{8, 0x32}: "Mission Critical Computing",
+	{8, 0xB3}: "Rotronic AG",
+	// Bank 9.
+	{9, 0x01}: "3D PLUS",
+	{9, 0x02}: "Diehl Aerospace",
+	{9, 0x83}: "Fairchild",
+	{9, 0x04}: "Mercury Systems",
+	{9, 0x85}: "Sonics Inc",
+	{9, 0x86}: "Emerson Automation Solutions",
+	{9, 0x07}: "Shenzhen Jinge Information Co Ltd",
+	{9, 0x08}: "SCWW",
+	{9, 0x89}: "Silicon Motion Inc.",
+	{9, 0x8A}: "Anurag",
+	{9, 0x0B}: "King Kong",
+	{9, 0x8C}: "FROM30 Co Ltd",
+	{9, 0x0D}: "Gowin Semiconductor Corp",
+	{9, 0x0E}: "Fremont Micro Devices Ltd",
+	{9, 0x8F}: "Ericsson Modems",
+	{9, 0x10}: "Exelis",
+	{9, 0x91}: "Satixfy Ltd",
+	{9, 0x92}: "Galaxy Microsystems Ltd",
+	{9, 0x13}: "Gloway International Co Ltd",
+	{9, 0x94}: "Lab",
+	{9, 0x15}: "Smart Energy Instruments",
+	{9, 0x16}: "Approved Memory Corporation",
+	{9, 0x97}: "Axell Corporation",
+	{9, 0x98}: "Essencore Limited",
+	{9, 0x19}: "Phytium",
+	{9, 0x1A}: "Xi'an UniIC Semiconductors Co Ltd",
+	{9, 0x9B}: "Ambiq Micro",
+	{9, 0x1C}: "eveRAM Technology Inc",
+	{9, 0x9D}: "Infomax",
+	{9, 0x9E}: "Butterfly Network Inc",
+	{9, 0x1F}: "Shenzhen City Gcai Electronics",
+	{9, 0x20}: "Stack Devices Corporation",
+	{9, 0xA1}: "ADK Media Group",
+	{9, 0xA2}: "TSP Global Co Ltd",
+	{9, 0x23}: "HighX",
+	{9, 0xA4}: "Shenzhen Elicks Technology",
+	{9, 0x25}: "XinKai/Silicon Kaiser",
+	{9, 0x26}: "Google Inc",
+	{9, 0xA7}: "Dasima International Development",
+	{9, 0xA8}: "Leahkinn Technology Limited",
+	{9, 0x29}: "HIMA Paul Hildebrandt GmbH Co KG",
+	{9, 0x2A}: "Keysight Technologies",
+	{9, 0xAB}: "Techcomp International (Fastable)",
+	{9, 0x2C}: "Ancore Technology Corporation",
+	{9, 0xAD}: "Nuvoton",
+	{9, 0xAE}: "Korea Uhbele International Group Ltd",
+	{9, 0x2F}: "Ikegami Tsushinki Co Ltd",
+	{9, 0xB0}: "RelChip Inc",
+	{9, 0x31}: "Baikal Electronics",
+	{9, 0x32}: "Nemostech Inc",
+	{9, 0xB3}: "Memorysolution GmbH",
+	{9, 0x34}: "Silicon Integrated Systems Corporation",
+	{9, 0xB5}: "Xiede",
+	{9, 0xB6}: "BRC",
+	{9, 0x37}: "Flash Chi",
+	{9, 0x38}: "Jone",
+	{9, 0xB9}: "GCT Semiconductor Inc",
+	{9, 0xBA}: "Hong Kong Zetta Device Technology",
+	{9, 0x3B}: "Unimemory Technology(s) Pte Ltd",
+	{9, 0xBC}: "Cuso",
+	{9, 0x3D}: "Kuso",
+	{9, 0x3E}: "Uniquify Inc",
+	{9, 0xBF}: "Skymedi Corporation",
+	{9, 0x40}: "Core Chance Co Ltd",
+	{9, 0xC1}: "Tekism Co Ltd",
+	{9, 0xC2}: "Seagate Technology PLC",
+	{9, 0x43}: "Hong Kong Gaia Group Co Limited",
+	{9, 0xC4}: "Gigacom Semiconductor LLC",
+	{9, 0x45}: "V2 Technologies",
+	{9, 0x46}: "TLi",
+	{9, 0xC7}: "Neotion",
+	{9, 0xC8}: "Lenovo",
+	{9, 0x49}: "Shenzhen Zhongteng Electronic Corp Ltd",
+	{9, 0x4A}: "Compound Photonics",
+	{9, 0xCB}: "in2H2 inc",
+	{9, 0x4C}: "Shenzhen Pango Microsystems Co Ltd",
+	{9, 0xCD}: "Vasekey",
+	{9, 0xCE}: "Cal-Comp Industria de Semicondutores",
+	{9, 0x4F}: "Eyenix Co Ltd",
+	{9, 0xD0}: "Heoriady",
+	{9, 0x51}: "Accelerated Memory Production Inc",
+	{9, 0x52}: "INVECAS Inc",
+	{9, 0xD3}: "AP Memory",
+	{9, 0x54}: "Douqi Technology",
+	{9, 0xD5}: "Etron Technology Inc",
+	{9, 0xD6}: "Indie Semiconductor",
+	{9, 0x57}: "Socionext Inc",
+	{9, 0x58}: "HGST",
+	{9, 0xD9}: "EVGA",
+	{9, 0xDA}: "Audience Inc",
+	{9, 0x5B}: "EpicGear",
+	{9, 0xDC}: "Vitesse Enterprise Co",
+	{9, 0x5D}: "Foxtronn International Corporation",
+	{9, 0x5E}: "Bretelon Inc",
+	{9, 0xDF}: "Graphcore",
+	{9, 0xE0}: "Eoplex Inc",
+	{9, 0x61}: "MaxLinear Inc",
+	{9, 0x62}: "ETA Devices",
+	{9, 0xE3}: "LOKI",
+	{9, 0x64}: "IMS Electronics Co Ltd",
+	{9, 0xE5}: "Dosilicon Co Ltd",
+	{9, 0xE6}: "Dolphin Integration",
+	{9, 0x67}: "Shenzhen Mic Electronics Technolog",
+	{9, 0x68}: "Boya Microelectronics Inc",
+	{9, 0xE9}: "Geniachip (Roche)",
+	{9, 0xEA}: "Axign",
+	{9, 0x6B}: "Kingred Electronic Technology Ltd",
+	{9, 0xEC}: "Chao Yue Zhuo Computer Business Dept.",
+	{9, 0x6D}: "Guangzhou Si Nuo Electronic Technology.",
+	{9, 0x6E}: "Crocus Technology Inc",
+	{9, 0xEF}: "Creative Chips GmbH",
+	{9, 0x70}: "GE Aviation Systems LLC.",
+	{9, 0xF1}: "Asgard",
+	{9, 0xF2}: "Good Wealth Technology Ltd",
+	{9, 0x73}: "TriCor Technologies",
+	{9, 0xF4}: "Nova-Systems GmbH",
+	{9, 0x75}: "JUHOR",
+	{9, 0x76}: "Zhuhai Douke Commerce Co Ltd",
+	{9, 0xF7}: "DSL Memory",
+	{9, 0xF8}: "Anvo-Systems Dresden GmbH",
+	{9, 0x79}: "Realtek",
+	{9, 0x7A}: "AltoBeam",
+	{9, 0xFB}: "Wave Computing",
+	{9, 0x7C}: "Beijing TrustNet Technology Co Ltd",
+	{9, 0xFD}: "Innovium Inc",
+	{9, 0xFE}: "Starsway Technology Limited",
+	// Bank 10.
+	{10, 0x01}: "Weltronics Co. LTD",
+	{10, 0x02}: "VMware Inc",
+	{10, 0x83}: "Hewlett Packard Enterprise",
+	{10, 0x04}: "INTENSO",
+	{10, 0x85}: "Puya Semiconductor",
+	{10, 0x86}: "MEMORFI",
+	{10, 0x07}: "MSC Technologies GmbH",
+	{10, 0x08}: "Txrui",
+	{10, 0x89}: "SiFive Inc",
+	{10, 0x8A}: "Spreadtrum Communications",
+	{10, 0x0B}: "XTX Technology Limited",
+	{10, 0x8C}: "UMAX Technology",
+	{10, 0x0D}: "Shenzhen Yong Sheng Technology",
+	{10, 0x0E}: "SNOAMOO (Shenzhen Kai Zhuo Yue)",
+	{10, 0x8F}: "Daten Tecnologia LTDA",
+	{10, 0x10}: "Shenzhen XinRuiYan Electronics",
+	{10, 0x91}: "Eta Compute",
+	{10, 0x92}: "Energous",
+	{10, 0x13}: "Raspberry Pi Trading Ltd",
+	{10, 0x94}: "Shenzhen Chixingzhe Tech Co Ltd",
+	{10, 0x15}: "Silicon Mobility",
+	{10, 0x16}: "IQ-Analog Corporation",
+	{10, 0x97}: "Uhnder Inc",
+	{10, 0x98}: "Impinj",
+	{10, 0x19}: "DEPO Computers",
+	{10, 0x1A}: "Nespresso",
+	{10, 0x9B}: "Yangtze Memory Technologies Co Ltd",
+	// Bank 11.
+	{11, 0x16}: "Ampere Computing",
+	{11, 0xB6}: "Nuclei System Technology",
+	// Bank 13.
+	{13, 0x02}: "OpenHW Group",
+	{13, 0xEF}: "lowRISC",
+}
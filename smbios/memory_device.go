@@ -7,8 +7,7 @@ package smbios
 import (
 	"fmt"
 	"strconv"
-
-	"github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
+	"strings"
 )
 
 // MemoryDevice represents a SMBIOS memory device.
@@ -104,7 +103,7 @@ type MemoryDevice struct {
 	// is not changeable.
 	PartNumber string
 	// Attributes returns the memory device attributes.
-	Attributes uint8
+	Attributes MemoryDeviceAttributes
 	// ExtendedSize returns the memory device extended size.
 	// The Extended Size field is intended to represent memory devices larger than 32,767 MB (32 GB - 1 MB),
 	// which cannot be described using the Size field. This field is only meaningful if the value in the Size field
@@ -131,10 +130,59 @@ type MemoryDevice struct {
 	// ConfiguredVoltage is the configured voltage for this device, in millivolts
 	// If the value is 0, the voltage is unknown.
 	ConfiguredVoltage MemoryDeviceVoltage
+	// MemoryTechnology returns the memory technology type for this memory device.
+	// See 7.18.6 for definitions.
+	MemoryTechnology MemoryTechnology
+	// MemoryOperatingModeCapability returns the operating modes supported by this memory device.
+	// See 7.18.7 for definitions.
+	MemoryOperatingModeCapability MemoryOperatingModeCapability
+	// FirmwareVersion returns the firmware version of this memory device.
+	FirmwareVersion string
+	// ModuleManufacturerID returns the manufacturer ID found in the SPD of this memory device,
+	// LSB first. 0 indicates that the manufacturer ID is unknown.
+	ModuleManufacturerID MemoryDeviceManufacturerID
+	// ModuleProductID returns the product ID found in the SPD of this memory device,
+	// LSB first. 0 indicates that the product ID is unknown.
+	ModuleProductID MemoryDeviceProductID
+	// MemorySubsystemControllerManufacturerID returns the manufacturer ID of the memory
+	// subsystem controller, LSB first. 0 indicates that the manufacturer ID is unknown.
+	MemorySubsystemControllerManufacturerID MemoryDeviceManufacturerID
+	// MemorySubsystemControllerProductID returns the product ID of the memory subsystem
+	// controller, LSB first. 0 indicates that the product ID is unknown.
+	MemorySubsystemControllerProductID MemoryDeviceProductID
+	// NonVolatileSize returns the size of the non-volatile portion of the memory device, in bytes.
+	NonVolatileSize MemoryDeviceRegionSize
+	// VolatileSize returns the size of the volatile portion of the memory device, in bytes.
+	VolatileSize MemoryDeviceRegionSize
+	// CacheSize returns the size of the cache portion of the memory device, in bytes.
+	CacheSize MemoryDeviceRegionSize
+	// LogicalSize returns the size of the logical memory device, in bytes.
+	LogicalSize MemoryDeviceRegionSize
+	// ExtendedSpeed returns the maximum capable speed of the device, in MT/s.
+	// This field is only meaningful if the value in the Speed field is FFFFh.
+	ExtendedSpeed MemoryDeviceExtendedSpeed
+	// ExtendedConfiguredMemorySpeed returns the configured speed of the memory device, in MT/s.
+	// This field is only meaningful if the value in the Configured Memory Speed field is FFFFh.
+	ExtendedConfiguredMemorySpeed MemoryDeviceExtendedSpeed
+	// PMIC0ManufacturerID returns the manufacturer ID of the PMIC0 of this memory device,
+	// LSB first. 0 indicates that the manufacturer ID is unknown.
+	PMIC0ManufacturerID MemoryDeviceManufacturerID
+	// PMIC0RevisionNumber returns the revision number of the PMIC0 of this memory device,
+	// as found in the SPD. FF00h indicates that the revision number is unknown.
+	PMIC0RevisionNumber MemoryDeviceRevisionNumber
+	// RCDManufacturerID returns the manufacturer ID of the Registering Clock Driver (RCD)
+	// of this memory device, LSB first. 0 indicates that the manufacturer ID is unknown.
+	RCDManufacturerID MemoryDeviceManufacturerID
+	// RCDRevisionNumber returns the revision number of the Registering Clock Driver (RCD)
+	// of this memory device, as found in the SPD. FF00h indicates that the revision number is unknown.
+	RCDRevisionNumber MemoryDeviceRevisionNumber
+
+	// FieldPresence returns the fields that the structure was too short to contain.
+	FieldPresence
 }
 
 // NewMemoryDevice initializes and returns a new `MemoryDevice`.
-func NewMemoryDevice(s *smbios.Structure) *MemoryDevice {
+func NewMemoryDevice(s *Structure) *MemoryDevice {
 	return &MemoryDevice{
 		PhysicalMemoryArrayHandle:    PhysicalMemoryArrayHandle(GetWord(s, 0x04)),
 		MemoryErrorInformationHandle: MemoryErrorInformationHandle(GetWord(s, 0x06)),
@@ -152,13 +200,83 @@ func NewMemoryDevice(s *smbios.Structure) *MemoryDevice {
 		SerialNumber:                 GetStringOrEmpty(s, 0x18),
 		AssetTag:                     GetStringOrEmpty(s, 0x19),
 		PartNumber:                   GetStringOrEmpty(s, 0x1A),
-		Attributes:                   GetByte(s, 0x1B),
+		Attributes:                   MemoryDeviceAttributes(GetByte(s, 0x1B)),
 		ExtendedSize:                 MemoryDeviceExtendedSize(GetDWord(s, 0x1C)),
 		ConfiguredMemorySpeed:        MemoryDeviceSpeed(GetWord(s, 0x20)),
 		MinimumVoltage:               MemoryDeviceVoltage(GetWord(s, 0x22)),
 		MaximumVoltage:               MemoryDeviceVoltage(GetWord(s, 0x24)),
 		ConfiguredVoltage:            MemoryDeviceVoltage(GetWord(s, 0x26)),
+
+		MemoryTechnology:                        MemoryTechnology(GetByte(s, 0x28)),
+		MemoryOperatingModeCapability:           MemoryOperatingModeCapability(GetWord(s, 0x29)),
+		FirmwareVersion:                         GetStringOrEmpty(s, 0x2B),
+		ModuleManufacturerID:                    MemoryDeviceManufacturerID(GetWord(s, 0x2C)),
+		ModuleProductID:                         MemoryDeviceProductID(GetWord(s, 0x2E)),
+		MemorySubsystemControllerManufacturerID: MemoryDeviceManufacturerID(GetWord(s, 0x30)),
+		MemorySubsystemControllerProductID:      MemoryDeviceProductID(GetWord(s, 0x32)),
+		NonVolatileSize:                         MemoryDeviceRegionSize(GetQWord(s, 0x34)),
+		VolatileSize:                            MemoryDeviceRegionSize(GetQWord(s, 0x3C)),
+		CacheSize:                               MemoryDeviceRegionSize(GetQWord(s, 0x44)),
+		LogicalSize:                             MemoryDeviceRegionSize(GetQWord(s, 0x4C)),
+		ExtendedSpeed:                           MemoryDeviceExtendedSpeed(GetDWord(s, 0x54)),
+		ExtendedConfiguredMemorySpeed:           MemoryDeviceExtendedSpeed(GetDWord(s, 0x58)),
+		PMIC0ManufacturerID:                     MemoryDeviceManufacturerID(GetWord(s, 0x5C)),
+		PMIC0RevisionNumber:                     MemoryDeviceRevisionNumber(GetWord(s, 0x5E)),
+		RCDManufacturerID:                       MemoryDeviceManufacturerID(GetWord(s, 0x60)),
+		RCDRevisionNumber:                       MemoryDeviceRevisionNumber(GetWord(s, 0x62)),
+		FieldPresence:                           _GetFieldPresence(s),
+	}
+}
+
+// MarshalJSON implements `json.Marshaler`, encoding absent fields as null.
+func (m MemoryDevice) MarshalJSON() ([]byte, error) {
+	return _MarshalJSONWithPresence(m, m.FieldPresence)
+}
+
+// Capacity returns the size of the memory device, using the Extended Size
+// field when the Size field says so. It returns 0 if no device is installed
+// or the size is unknown.
+func (m MemoryDevice) Capacity() Capacity {
+	if m.Size == 0x7FFF {
+		return m.ExtendedSize.Capacity()
 	}
+
+	return m.Size.Capacity()
+}
+
+// ManufacturerName returns the name of the manufacturer of the memory device.
+// It prefers the JEP106 module manufacturer ID, then a JEP106 ID hex encoded
+// in the Manufacturer field, and falls back to the Manufacturer field itself.
+func (m MemoryDevice) ManufacturerName() string {
+	if name := m.ModuleManufacturerID.Manufacturer(); name != "" {
+		return name
+	}
+
+	if id, ok := ParseJEP106(m.Manufacturer); ok {
+		return id.Manufacturer()
+	}
+
+	return m.Manufacturer
+}
+
+// MaximumSpeed returns the maximum capable speed of the device, in MT/s,
+// taking the Extended Speed field into account. 0 indicates that the speed is unknown.
+func (m MemoryDevice) MaximumSpeed() uint32 {
+	return _GetMemoryDeviceSpeed(m.Speed, m.ExtendedSpeed)
+}
+
+// ConfiguredSpeed returns the configured speed of the device, in MT/s, taking
+// the Extended Configured Memory Speed field into account. 0 indicates that the speed is unknown.
+func (m MemoryDevice) ConfiguredSpeed() uint32 {
+	return _GetMemoryDeviceSpeed(m.ConfiguredMemorySpeed, m.ExtendedConfiguredMemorySpeed)
+}
+
+func _GetMemoryDeviceSpeed(speed MemoryDeviceSpeed, extended MemoryDeviceExtendedSpeed) uint32 {
+	if speed == 0xFFFF {
+		return extended.MTs()
+	}
+
+	return uint32(speed)
 }
 
 // PhysicalMemoryArrayHandle represents the SMBIOS physical memory array handle.
@@ -201,11 +319,21 @@ type MemoryDeviceSize uint16
 
 // Megabytes returns the size of the SMBIOS memory device converted to megabytes.
 func (m MemoryDeviceSize) Megabytes() int {
+	return int(m.Capacity() / Megabyte)
+}
+
+// Capacity returns the size of the SMBIOS memory device. It returns 0 if no device
+// is installed, the size is unknown, or the size is stored in the Extended Size field.
+func (m MemoryDeviceSize) Capacity() Capacity {
+	if m == 0xFFFF || m == 0x7FFF {
+		return 0
+	}
+
 	if IsNthBitSet(int(m), 15) {
-		return int(m) / 1024
+		return Capacity(m&0x7FFF) * Kilobyte
 	}
 
-	return int(m)
+	return Capacity(m) * Megabyte
 }
 
 // String returns the string representation of the SMBIOS memory device size.
@@ -218,22 +346,29 @@ func (m MemoryDeviceSize) String() string {
 		return "See Extended Size"
 	}
 
-	var units string
-	if IsNthBitSet(int(m), 15) {
-		units = "KB"
-	} else {
-		units = "MB"
+	if m == 0 {
+		return "No Module Installed"
 	}
 
-	return fmt.Sprintf("%d %s", m, units)
+	return m.Capacity().String()
+}
+
+// MemoryDeviceExtendedSize represents the SMBIOS memory device extended size, in megabytes.
+type MemoryDeviceExtendedSize uint32
+
+// Megabytes returns the extended size in megabytes. Bit 31 is reserved and ignored.
+func (m MemoryDeviceExtendedSize) Megabytes() int {
+	return int(m & 0x7FFFFFFF)
 }
 
-// MemoryDeviceExtendedSize represents the SMBIOS memory device extended size.
-type MemoryDeviceExtendedSize uint16
+// Capacity returns the extended size of the SMBIOS memory device.
+func (m MemoryDeviceExtendedSize) Capacity() Capacity {
+	return Capacity(m.Megabytes()) * Megabyte
+}
 
 // String returns the string representation of the SMBIOS memory device extended size.
 func (m MemoryDeviceExtendedSize) String() string {
-	return fmt.Sprintf("%d MB", m)
+	return m.Capacity().String()
 }
 
 // FormFactor represents the SMBIOS memory device form factor.
@@ -314,7 +449,7 @@ func (f FormFactor) String() string {
 	return _Unknown
 }
 
-func _GetDeviceSet(s *smbios.Structure, offset int) string {
+func _GetDeviceSet(s *Structure, offset int) string {
 	b := GetByte(s, offset)
 
 	if b == 0 {
@@ -596,6 +731,18 @@ func (m MemoryDeviceSpeed) String() string {
 	return fmt.Sprintf("%d MT/s", m)
 }
 
+// MemoryDeviceExtendedSpeed represents the SMBIOS memory device extended speed.
+type MemoryDeviceExtendedSpeed uint32
+
+// MTs returns the speed in MT/s. Bit 31 is reserved and ignored.
+func (m MemoryDeviceExtendedSpeed) MTs() uint32 {
+	return uint32(m) & 0x7FFFFFFF
+}
+
+func (m MemoryDeviceExtendedSpeed) String() string {
+	return fmt.Sprintf("%d MT/s", m.MTs())
+}
+
 // MemoryDeviceVoltage represents the SMBIOS memory device voltage.
 type MemoryDeviceVoltage uint16
 
@@ -624,3 +771,190 @@ func bits(input int) []int {
 
 	return b
 }
+
+// MemoryDeviceAttributes represents the SMBIOS memory device attributes.
+type MemoryDeviceAttributes uint8
+
+// Rank returns the rank of the memory device. 0 indicates that the rank is unknown.
+func (m MemoryDeviceAttributes) Rank() int {
+	return int(m & 0x0F)
+}
+
+// String returns the string representation of the SMBIOS memory device attributes.
+func (m MemoryDeviceAttributes) String() string {
+	if m.Rank() == 0 {
+		return "Rank: Unknown"
+	}
+
+	return fmt.Sprintf("Rank: %d", m.Rank())
+}
+
+// MemoryTechnology represents the SMBIOS memory device technology.
+type MemoryTechnology int
+
+const (
+	// MemoryTechnologyOther is a memory technology type.
+	MemoryTechnologyOther MemoryTechnology = iota + 1
+	// MemoryTechnologyUnknown is a memory technology type.
+	MemoryTechnologyUnknown
+	// MemoryTechnologyDRAM is a memory technology type.
+	MemoryTechnologyDRAM
+	// MemoryTechnologyNVDIMMN is a memory technology type.
+	MemoryTechnologyNVDIMMN
+	// MemoryTechnologyNVDIMMF is a memory technology type.
+	MemoryTechnologyNVDIMMF
+	// MemoryTechnologyNVDIMMP is a memory technology type.
+	MemoryTechnologyNVDIMMP
+	// MemoryTechnologyIntelOptanePersistentMemory is a memory technology type.
+	MemoryTechnologyIntelOptanePersistentMemory
+)
+
+// String returns the string representation of a `MemoryTechnology`.
+func (m MemoryTechnology) String() string {
+	switch m {
+	case MemoryTechnologyOther:
+		return _Other
+	case MemoryTechnologyUnknown:
+		return _Unknown
+	case MemoryTechnologyDRAM:
+		return "DRAM"
+	case MemoryTechnologyNVDIMMN:
+		return "NVDIMM-N"
+	case MemoryTechnologyNVDIMMF:
+		return "NVDIMM-F"
+	case MemoryTechnologyNVDIMMP:
+		return "NVDIMM-P"
+	case MemoryTechnologyIntelOptanePersistentMemory:
+		return "Intel Optane persistent memory"
+	}
+
+	return _Unknown
+}
+
+// MemoryOperatingModeCapability represents the SMBIOS memory device operating mode capability.
+type MemoryOperatingModeCapability uint16
+
+// memoryOperatingModeCapabilityNames maps operating mode capability bits to their names.
+var memoryOperatingModeCapabilityNames = []string{
+	1: _Other,
+	2: _Unknown,
+	3: "Volatile memory",
+	4: "Byte-accessible persistent memory",
+	5: "Block-accessible persistent memory",
+}
+
+// Volatile returns true if the device supports volatile memory mode.
+func (m MemoryOperatingModeCapability) Volatile() bool {
+	return IsNthBitSet(int(m), 3)
+}
+
+// ByteAccessiblePersistent returns true if the device supports byte-accessible persistent memory mode.
+func (m MemoryOperatingModeCapability) ByteAccessiblePersistent() bool {
+	return IsNthBitSet(int(m), 4)
+}
+
+// BlockAccessiblePersistent returns true if the device supports block-accessible persistent memory mode.
+func (m MemoryOperatingModeCapability) BlockAccessiblePersistent() bool {
+	return IsNthBitSet(int(m), 5)
+}
+
+// Modes returns the names of all supported operating modes.
+func (m MemoryOperatingModeCapability) Modes() []string {
+	return _GetBitNames(int(m), memoryOperatingModeCapabilityNames)
+}
+
+// String returns the string representation of a `MemoryOperatingModeCapability`.
+func (m MemoryOperatingModeCapability) String() string {
+	modes := m.Modes()
+	if len(modes) == 0 {
+		return "None"
+	}
+
+	return strings.Join(modes, ", ")
+}
+
+// MemoryDeviceManufacturerID represents a JEDEC JEP106 manufacturer ID as stored in the SPD:
+// the LSB holds the number of continuation codes, and the MSB holds the manufacturer code,
+// both with an odd parity bit.
+type MemoryDeviceManufacturerID uint16
+
+// Bank returns the JEP106 bank number, starting from 1.
+func (m MemoryDeviceManufacturerID) Bank() int {
+	return int(m&0x7F) + 1
+}
+
+// Code returns the JEP106 manufacturer code, including the parity bit.
+func (m MemoryDeviceManufacturerID) Code() uint8 {
+	return uint8(m >> 8)
+}
+
+// JEP106 returns the JEP106 ID of the manufacturer.
+func (m MemoryDeviceManufacturerID) JEP106() JEP106ID {
+	return JEP106ID{Bank: m.Bank(), Code: m.Code()}
+}
+
+// Manufacturer returns the name of the manufacturer, or an empty string if unknown.
+func (m MemoryDeviceManufacturerID) Manufacturer() string {
+	if m == 0 {
+		return _Empty
+	}
+
+	return m.JEP106().Manufacturer()
+}
+
+// String returns the string representation of a `MemoryDeviceManufacturerID`.
+func (m MemoryDeviceManufacturerID) String() string {
+	if m == 0 {
+		return _Unknown
+	}
+
+	return fmt.Sprintf("Bank %d, Hex 0x%02X", m.Bank(), m.Code())
+}
+
+// MemoryDeviceProductID represents a memory device product ID.
+type MemoryDeviceProductID uint16
+
+// String returns the string representation of a `MemoryDeviceProductID`.
+func (m MemoryDeviceProductID) String() string {
+	if m == 0 {
+		return _Unknown
+	}
+
+	return fmt.Sprintf("0x%04X", uint16(m))
+}
+
+// MemoryDeviceRevisionNumber represents a memory device component revision number.
+type MemoryDeviceRevisionNumber uint16
+
+// String returns the string representation of a `MemoryDeviceRevisionNumber`.
+func (m MemoryDeviceRevisionNumber) String() string {
+	if m == 0xFF00 {
+		return _Unknown
+	}
+
+	return fmt.Sprintf("0x%02X", uint8(m))
+}
+
+// MemoryDeviceRegionSize represents the size, in bytes, of a memory device region.
+type MemoryDeviceRegionSize uint64
+
+// Capacity returns the size of the memory device region. It returns 0 if the size is unknown.
+func (m MemoryDeviceRegionSize) Capacity() Capacity {
+	if m == 0xFFFFFFFFFFFFFFFF {
+		return 0
+	}
+
+	return Capacity(m)
+}
+
+// String returns the string representation of a `MemoryDeviceRegionSize`.
+func (m MemoryDeviceRegionSize) String() string {
+	switch m {
+	case 0:
+		return "None"
+	case 0xFFFFFFFFFFFFFFFF:
+		return _Unknown
+	}
+
+	return m.Capacity().String()
+}
@@ -4,7 +4,7 @@
 
 package smbios
 
-import "github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
+import "strings"
 
 // OEMStrings represents the SMBIOS OEM strings.
 type OEMStrings struct {
@@ -15,9 +15,91 @@ type OEMStrings struct {
 }
 
 // NewOEMStrings initializes and returns a new `OEMStrings`.
-func NewOEMStrings(s *smbios.Structure) *OEMStrings {
+func NewOEMStrings(s *Structure) *OEMStrings {
 	return &OEMStrings{
 		Count:   GetByte(s, 0x04),
 		Strings: GetStrings(s),
 	}
 }
+
+// CountMismatch returns true if the number of strings differs from the declared count.
+func (o OEMStrings) CountMismatch() bool {
+	return int(o.Count) != len(o.Strings)
+}
+
+// Pairs returns the key/value pairs parsed from the strings.
+// Strings that are not key/value pairs are skipped.
+func (o OEMStrings) Pairs() []OEMStringPair {
+	var pairs []OEMStringPair
+
+	for _, s := range o.Strings {
+		if pair, ok := ParseOEMString(s); ok {
+			pairs = append(pairs, pair)
+		}
+	}
+
+	return pairs
+}
+
+// Lookup returns the value of the first pair with the given key.
+func (o OEMStrings) Lookup(key string) (string, bool) {
+	for _, pair := range o.Pairs() {
+		if pair.Key == key {
+			return pair.Value, true
+		}
+	}
+
+	return _Empty, false
+}
+
+// OEMStringPair represents a key/value pair found in an OEM string.
+type OEMStringPair struct {
+	// Key returns the key.
+	Key string
+	// Value returns the value.
+	Value string
+}
+
+// ParseOEMString parses an OEM string in the `key=value` or `key:value` form.
+// The first `=` or `:` separates the key from the value, and surrounding
+// whitespace is trimmed from both. It returns false if the string
+// has no separator or the key is empty.
+func ParseOEMString(s string) (OEMStringPair, bool) {
+	i := strings.IndexAny(s, "=:")
+	if i < 0 {
+		return OEMStringPair{}, false
+	}
+
+	key := strings.TrimSpace(s[:i])
+	if key == "" {
+		return OEMStringPair{}, false
+	}
+
+	return OEMStringPair{
+		Key:   key,
+		Value: strings.TrimSpace(s[i+1:]),
+	}, true
+}
+
+// OEMStringPairs returns the key/value pairs parsed from all OEM strings structures, in table order.
+func (s *SMBIOS) OEMStringPairs() []OEMStringPair {
+	var pairs []OEMStringPair
+
+	for _, o := range s.OEMStrings {
+		pairs = append(pairs, o.Pairs()...)
+	}
+
+	return pairs
+}
+
+// LookupOEMString returns the value of the first pair with the given key
+// across all OEM strings structures.
+func (s *SMBIOS) LookupOEMString(key string) (string, bool) {
+	for _, o := range s.OEMStrings {
+		if value, ok := o.Lookup(key); ok {
+			return value, true
+		}
+	}
+
+	return _Empty, false
+}
@@ -0,0 +1,134 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smbios
+
+// OnboardDevice represents the SMBIOS onboard devices extended information.
+type OnboardDevice struct {
+	// ReferenceDesignation returns the onboard device reference designation.
+	ReferenceDesignation string
+	// DeviceType returns the type of the onboard device. See 7.42.2.
+	DeviceType OnboardDeviceType
+	// Enabled returns true if the device is enabled.
+	Enabled bool
+	// DeviceTypeInstance returns the instance number of the device, unique
+	// within the devices of the same type.
+	DeviceTypeInstance uint8
+	// SegmentGroupNumber returns the PCI segment group number of the device.
+	SegmentGroupNumber uint16
+	// BusNumber returns the PCI bus number of the device.
+	BusNumber uint8
+	// DeviceFunctionNumber returns the PCI device and function number of the device.
+	DeviceFunctionNumber DeviceFunctionNumber
+}
+
+// NewOnboardDevice initializes and returns a new `OnboardDevice`.
+func NewOnboardDevice(s *Structure) *OnboardDevice {
+	deviceType := GetByte(s, 0x05)
+
+	return &OnboardDevice{
+		ReferenceDesignation: GetStringOrEmpty(s, 0x04),
+		DeviceType:           OnboardDeviceType(deviceType & 0x7F),
+		Enabled:              IsNthBitSet(int(deviceType), 7),
+		DeviceTypeInstance:   GetByte(s, 0x06),
+		SegmentGroupNumber:   GetWord(s, 0x07),
+		BusNumber:            GetByte(s, 0x09),
+		DeviceFunctionNumber: DeviceFunctionNumber(GetByte(s, 0x0A)),
+	}
+}
+
+// HasPCIAddress returns true if the device provides segment/bus/device/function information.
+func (o OnboardDevice) HasPCIAddress() bool {
+	return o.SegmentGroupNumber != 0xFFFF && o.BusNumber != 0xFF && o.DeviceFunctionNumber != 0xFF
+}
+
+// PCIAddress returns the PCI address of the device.
+func (o OnboardDevice) PCIAddress() PCIAddress {
+	return PCIAddress{
+		Segment:  o.SegmentGroupNumber,
+		Bus:      o.BusNumber,
+		Device:   o.DeviceFunctionNumber.Device(),
+		Function: o.DeviceFunctionNumber.Function(),
+	}
+}
+
+// OnboardDeviceType represents the onboard device type.
+type OnboardDeviceType int
+
+const (
+	// OnboardDeviceTypeOther is an onboard device type.
+	OnboardDeviceTypeOther OnboardDeviceType = iota + 1
+	// OnboardDeviceTypeUnknown is an onboard device type.
+	OnboardDeviceTypeUnknown
+	// OnboardDeviceTypeVideo is an onboard device type.
+	OnboardDeviceTypeVideo
+	// OnboardDeviceTypeSCSIController is an onboard device type.
+	OnboardDeviceTypeSCSIController
+	// OnboardDeviceTypeEthernet is an onboard device type.
+	OnboardDeviceTypeEthernet
+	// OnboardDeviceTypeTokenRing is an onboard device type.
+	OnboardDeviceTypeTokenRing
+	// OnboardDeviceTypeSound is an onboard device type.
+	OnboardDeviceTypeSound
+	// OnboardDeviceTypePATAController is an onboard device type.
+	OnboardDeviceTypePATAController
+	// OnboardDeviceTypeSATAController is an onboard device type.
+	OnboardDeviceTypeSATAController
+	// OnboardDeviceTypeSASController is an onboard device type.
+	OnboardDeviceTypeSASController
+	// OnboardDeviceTypeWirelessLAN is an onboard device type.
+	OnboardDeviceTypeWirelessLAN
+	// OnboardDeviceTypeBluetooth is an onboard device type.
+	OnboardDeviceTypeBluetooth
+	// OnboardDeviceTypeWWAN is an onboard device type.
+	OnboardDeviceTypeWWAN
+	// OnboardDeviceTypeEMMC is an onboard device type.
+	OnboardDeviceTypeEMMC
+	// OnboardDeviceTypeNVMeController is an onboard device type.
+	OnboardDeviceTypeNVMeController
+	// OnboardDeviceTypeUFSController is an onboard device type.
+	OnboardDeviceTypeUFSController
+)
+
+// String returns the string representation of an `OnboardDeviceType`.
+//
+//nolint:gocyclo,cyclop
+func (o OnboardDeviceType) String() string {
+	switch o {
+	case OnboardDeviceTypeOther:
+		return _Other
+	case OnboardDeviceTypeUnknown:
+		return _Unknown
+	case OnboardDeviceTypeVideo:
+		return "Video"
+	case OnboardDeviceTypeSCSIController:
+		return "SCSI Controller"
+	case OnboardDeviceTypeEthernet:
+		return "Ethernet"
+	case OnboardDeviceTypeTokenRing:
+		return "Token Ring"
+	case OnboardDeviceTypeSound:
+		return "Sound"
+	case OnboardDeviceTypePATAController:
+		return "PATA Controller"
+	case OnboardDeviceTypeSATAController:
+		return "SATA Controller"
+	case OnboardDeviceTypeSASController:
+		return "SAS Controller"
+	case OnboardDeviceTypeWirelessLAN:
+		return "Wireless LAN"
+	case OnboardDeviceTypeBluetooth:
+		return "Bluetooth"
+	case OnboardDeviceTypeWWAN:
+		return "WWAN"
+	case OnboardDeviceTypeEMMC:
+		return "eMMC (embedded Multi-Media Controller)"
+	case OnboardDeviceTypeNVMeController:
+		return "NVMe Controller"
+	case OnboardDeviceTypeUFSController:
+		return "UFS Controller"
+	}
+
+	return _Unknown
+}
@@ -0,0 +1,344 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smbios
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultPCIDevicesPath is the default sysfs directory listing PCI devices.
+const DefaultPCIDevicesPath = "/sys/bus/pci/devices"
+
+// PCIDevice represents a PCI device found in sysfs.
+type PCIDevice struct {
+	// Address returns the PCI address of the device.
+	Address PCIAddress
+	// VendorID returns the PCI vendor ID of the device.
+	VendorID uint16
+	// DeviceID returns the PCI device ID of the device.
+	DeviceID uint16
+	// SubsystemVendorID returns the PCI subsystem vendor ID of the device.
+	SubsystemVendorID uint16
+	// SubsystemDeviceID returns the PCI subsystem device ID of the device.
+	SubsystemDeviceID uint16
+	// Class returns the PCI class code of the device (class, subclass and programming interface).
+	Class uint32
+	// NetDevices returns the names of the network interfaces provided by the device.
+	NetDevices []string
+	// BlockDevices returns the names of the block devices provided by the device.
+	BlockDevices []string
+}
+
+// BaseClass returns the PCI base class of the device.
+func (p PCIDevice) BaseClass() uint8 {
+	return uint8(p.Class >> 16)
+}
+
+// SubClass returns the PCI subclass of the device.
+func (p PCIDevice) SubClass() uint8 {
+	return uint8(p.Class >> 8)
+}
+
+// IsBridge returns true if the device is a PCI bridge.
+func (p PCIDevice) IsBridge() bool {
+	return p.BaseClass() == 0x06
+}
+
+// SlotPCIDevices represents a system slot and the PCI devices behind it.
+type SlotPCIDevices struct {
+	// Slot returns the system slot.
+	Slot SystemSlot
+	// Devices returns the PCI devices behind the slot.
+	Devices []PCIDevice
+}
+
+// OnboardPCIDevices represents an onboard device and the matching PCI devices.
+type OnboardPCIDevices struct {
+	// Device returns the onboard device.
+	Device OnboardDevice
+	// Devices returns the matching PCI devices.
+	Devices []PCIDevice
+}
+
+// SlotPCIDevices resolves each system slot to the PCI devices behind it,
+// using the sysfs PCI devices directory at root. If root is empty,
+// `DefaultPCIDevicesPath` is used.
+//
+// A slot matches all functions of the device at the slot address and of
+// its peer devices, and every device downstream of them. When the device at
+// an address is a bridge, such as a root port, only that function matches.
+// Slots without the SMBIOS 2.6 address fields are skipped.
+func (s *SMBIOS) SlotPCIDevices(root string) ([]SlotPCIDevices, error) {
+	tree, err := readPCITree(root)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]SlotPCIDevices, 0, len(s.SystemSlots))
+
+	for _, slot := range s.SystemSlots {
+		if !slot.Has("SegmentGroupNumber") {
+			continue
+		}
+
+		var addresses []PCIAddress
+
+		if slot.HasPCIAddress() {
+			addresses = append(addresses, slot.PCIAddress())
+		}
+
+		for _, peer := range slot.PeerGroups {
+			addresses = append(addresses, peer.PCIAddress())
+		}
+
+		devices, err := tree.devices(addresses, true)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, SlotPCIDevices{Slot: slot, Devices: devices})
+	}
+
+	return result, nil
+}
+
+// OnboardPCIDevices resolves each onboard device to the matching PCI devices,
+// using the sysfs PCI devices directory at root. If root is empty,
+// `DefaultPCIDevicesPath` is used.
+//
+// An onboard device matches the device at its exact address,
+// and every device downstream of it.
+func (s *SMBIOS) OnboardPCIDevices(root string) ([]OnboardPCIDevices, error) {
+	tree, err := readPCITree(root)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]OnboardPCIDevices, 0, len(s.OnboardDevices))
+
+	for _, device := range s.OnboardDevices {
+		var addresses []PCIAddress
+
+		if device.HasPCIAddress() {
+			addresses = append(addresses, device.PCIAddress())
+		}
+
+		devices, err := tree.devices(addresses, false)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, OnboardPCIDevices{Device: device, Devices: devices})
+	}
+
+	return result, nil
+}
+
+type pciEntry struct {
+	address PCIAddress
+	path    string
+}
+
+type pciTree []pciEntry
+
+func readPCITree(root string) (pciTree, error) {
+	if root == "" {
+		root = DefaultPCIDevicesPath
+	}
+
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read PCI devices: %w", err)
+	}
+
+	tree := make(pciTree, 0, len(entries))
+
+	for _, entry := range entries {
+		address, ok := parsePCIAddress(entry.Name())
+		if !ok {
+			continue
+		}
+
+		// The entries are usually symlinks into the device hierarchy, which
+		// is resolved to find the devices downstream of a bridge.
+		path, err := filepath.EvalSymlinks(filepath.Join(root, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve PCI device %s: %w", entry.Name(), err)
+		}
+
+		tree = append(tree, pciEntry{address: address, path: path})
+	}
+
+	return tree, nil
+}
+
+func (t pciTree) devices(addresses []PCIAddress, allFunctions bool) ([]PCIDevice, error) {
+	var parents []string
+
+	for _, address := range addresses {
+		functions := allFunctions
+
+		// The functions of a bridge device are usually separate root ports,
+		// feeding different slots.
+		if functions {
+			bridge, err := t.isBridge(address)
+			if err != nil {
+				return nil, err
+			}
+
+			functions = !bridge
+		}
+
+		for _, entry := range t {
+			if entry.address.Segment == address.Segment && entry.address.Bus == address.Bus && entry.address.Device == address.Device &&
+				(functions || entry.address.Function == address.Function) {
+				parents = append(parents, entry.path)
+			}
+		}
+	}
+
+	var devices []PCIDevice
+
+	for _, entry := range t {
+		for _, parent := range parents {
+			if entry.path != parent && !strings.HasPrefix(entry.path, parent+string(filepath.Separator)) {
+				continue
+			}
+
+			device, err := readPCIDevice(entry)
+			if err != nil {
+				return nil, err
+			}
+
+			devices = append(devices, device)
+
+			break
+		}
+	}
+
+	return devices, nil
+}
+
+func (t pciTree) isBridge(address PCIAddress) (bool, error) {
+	for _, entry := range t {
+		if entry.address != address {
+			continue
+		}
+
+		class, err := readPCIAttribute(entry.path, "class", 24)
+		if err != nil {
+			return false, err
+		}
+
+		return PCIDevice{Class: uint32(class)}.IsBridge(), nil
+	}
+
+	return false, nil
+}
+
+func readPCIDevice(entry pciEntry) (PCIDevice, error) {
+	device := PCIDevice{Address: entry.address}
+
+	for _, attr := range []struct {
+		name  string
+		value *uint16
+	}{
+		{"vendor", &device.VendorID},
+		{"device", &device.DeviceID},
+		{"subsystem_vendor", &device.SubsystemVendorID},
+		{"subsystem_device", &device.SubsystemDeviceID},
+	} {
+		v, err := readPCIAttribute(entry.path, attr.name, 16)
+		if err != nil {
+			return device, err
+		}
+
+		*attr.value = uint16(v)
+	}
+
+	class, err := readPCIAttribute(entry.path, "class", 24)
+	if err != nil {
+		return device, err
+	}
+
+	device.Class = uint32(class)
+
+	err = filepath.WalkDir(entry.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() {
+			return nil
+		}
+
+		if path != entry.path {
+			// Downstream PCI devices are reported on their own.
+			if _, ok := parsePCIAddress(d.Name()); ok {
+				return filepath.SkipDir
+			}
+		}
+
+		subsystem, err := os.Readlink(filepath.Join(path, "subsystem"))
+		if err != nil {
+			return nil //nolint:nilerr
+		}
+
+		switch filepath.Base(subsystem) {
+		case "net":
+			device.NetDevices = append(device.NetDevices, d.Name())
+		case "block":
+			if _, err := os.Stat(filepath.Join(path, "partition")); err != nil {
+				device.BlockDevices = append(device.BlockDevices, d.Name())
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return device, fmt.Errorf("failed to read PCI device %s: %w", entry.address, err)
+	}
+
+	return device, nil
+}
+
+func readPCIAttribute(path, name string, bitSize int) (uint64, error) {
+	b, err := os.ReadFile(filepath.Join(path, name))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return 0, nil
+		}
+
+		return 0, fmt.Errorf("failed to read PCI device attribute: %w", err)
+	}
+
+	v, err := strconv.ParseUint(strings.TrimPrefix(strings.TrimSpace(string(b)), "0x"), 16, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse PCI device attribute %s: %w", filepath.Join(path, name), err)
+	}
+
+	return v, nil
+}
+
+// parsePCIAddress parses a PCI address in the `0000:00:00.0` form.
+func parsePCIAddress(s string) (PCIAddress, bool) {
+	var address PCIAddress
+
+	if len(s) != len("0000:00:00.0") {
+		return address, false
+	}
+
+	if _, err := fmt.Sscanf(s, "%04x:%02x:%02x.%1x", &address.Segment, &address.Bus, &address.Device, &address.Function); err != nil {
+		return address, false
+	}
+
+	return address, true
+}
@@ -4,14 +4,10 @@
 
 package smbios
 
-import (
-	"fmt"
-
-	"github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
-)
-
 // PhysicalMemoryArray represents the SMBIOS physical memory array.
 type PhysicalMemoryArray struct {
+	// Handle returns the handle of the structure.
+	Handle PhysicalMemoryArrayHandle
 	// Location returns the physical location of the Memory Array,
 	// whether on the system board or an add-in board.
 	// See 7.17.1 for definitions.
@@ -53,11 +49,15 @@ type PhysicalMemoryArray struct {
 	// 8000 0000h, Extended Maximum Capacity must
 	// contain zeros.
 	ExtendedMaximumCapacity ExtendedMaximumCapacity
+
+	// FieldPresence returns the fields that the structure was too short to contain.
+	FieldPresence
 }
 
 // NewPhysicalMemoryArray initializes and returns a new `PhysicalMemoryArray`.
-func NewPhysicalMemoryArray(s *smbios.Structure) *PhysicalMemoryArray {
+func NewPhysicalMemoryArray(s *Structure) *PhysicalMemoryArray {
 	return &PhysicalMemoryArray{
+		Handle:                       PhysicalMemoryArrayHandle(s.Header.Handle),
 		Location:                     MemoryArrayLocation(GetByte(s, 0x04)),
 		Use:                          MemoryArrayUse(GetByte(s, 0x05)),
 		MemoryErrorCorrection:        MemoryArrayMemoryErrorCorrection(GetByte(s, 0x06)),
@@ -65,7 +65,61 @@ func NewPhysicalMemoryArray(s *smbios.Structure) *PhysicalMemoryArray {
 		MemoryErrorInformationHandle: MemoryErrorInformationHandle(GetWord(s, 0x0B)),
 		NumberOfMemoryDevices:        GetWord(s, 0x0D),
 		ExtendedMaximumCapacity:      ExtendedMaximumCapacity(GetQWord(s, 0x0F)),
+		FieldPresence:                _GetFieldPresence(s),
+	}
+}
+
+// MarshalJSON implements `json.Marshaler`, encoding absent fields as null.
+func (p PhysicalMemoryArray) MarshalJSON() ([]byte, error) {
+	return _MarshalJSONWithPresence(p, p.FieldPresence)
+}
+
+// Capacity returns the maximum memory capacity of the array, using the
+// Extended Maximum Capacity field when the Maximum Capacity field says so.
+func (p PhysicalMemoryArray) Capacity() Capacity {
+	if p.MaximumCapacity == 0x80000000 {
+		return p.ExtendedMaximumCapacity.Capacity()
+	}
+
+	return p.MaximumCapacity.Capacity()
+}
+
+// PhysicalMemoryArrayByHandle returns the physical memory array with the given handle,
+// or nil if there is none.
+func (s *SMBIOS) PhysicalMemoryArrayByHandle(handle PhysicalMemoryArrayHandle) *PhysicalMemoryArray {
+	for i := range s.PhysicalMemoryArray {
+		if s.PhysicalMemoryArray[i].Handle == handle {
+			return &s.PhysicalMemoryArray[i]
+		}
+	}
+
+	return nil
+}
+
+// PhysicalMemoryArrayDevices returns the memory devices belonging to the given physical memory array.
+func (s *SMBIOS) PhysicalMemoryArrayDevices(a PhysicalMemoryArray) []*MemoryDevice {
+	var devices []*MemoryDevice
+
+	for i := range s.MemoryDevices {
+		if s.MemoryDevices[i].PhysicalMemoryArrayHandle == a.Handle {
+			devices = append(devices, &s.MemoryDevices[i])
+		}
+	}
+
+	return devices
+}
+
+// PhysicalMemoryArraySlots returns the number of populated memory device slots
+// and the total number of slots of the given physical memory array.
+func (s *SMBIOS) PhysicalMemoryArraySlots(a PhysicalMemoryArray) (populated, total int) {
+	for _, device := range s.PhysicalMemoryArrayDevices(a) {
+		// A size of 0 means that no memory device is installed in the slot.
+		if device.Size != 0 {
+			populated++
+		}
 	}
+
+	return populated, int(a.NumberOfMemoryDevices)
 }
 
 // MemoryArrayLocation represents the memory array location.
@@ -232,15 +286,23 @@ func (m MemoryArrayMemoryErrorCorrection) String() string {
 // Capacity field.
 type MaximumCapacity uint32
 
+// Capacity returns the maximum capacity. It returns 0 if the
+// capacity is stored in the Extended Maximum Capacity field.
+func (m MaximumCapacity) Capacity() Capacity {
+	if m == 0x80000000 {
+		return 0
+	}
+
+	return Capacity(m) * Kilobyte
+}
+
 // String returns the string representation of a `MaximumCapacity`.
 func (m MaximumCapacity) String() string {
 	if m == 0x80000000 {
 		return ""
 	}
 
-	n := m / (1024 * 1024)
-
-	return fmt.Sprintf("%d GB", n)
+	return m.Capacity().String()
 }
 
 // ExtendedMaximumCapacity represents the physical memory
@@ -252,12 +314,16 @@ func (m MaximumCapacity) String() string {
 // contain zeros.
 type ExtendedMaximumCapacity uint64
 
+// Capacity returns the extended maximum capacity.
+func (m ExtendedMaximumCapacity) Capacity() Capacity {
+	return Capacity(m)
+}
+
+// String returns the string representation of an `ExtendedMaximumCapacity`.
 func (m ExtendedMaximumCapacity) String() string {
 	if m == 0x00000000 {
 		return ""
 	}
 
-	n := m / (1024 * 1024)
-
-	return fmt.Sprintf("%d GB", n)
+	return m.Capacity().String()
 }
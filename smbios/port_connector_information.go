@@ -4,20 +4,306 @@
 
 package smbios
 
-import "github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
-
 // PortConnectorInformation represents the port connector information.
 type PortConnectorInformation struct {
 	// InternalReferenceDesignator returns the port connector internal reference designator.
 	InternalReferenceDesignator string
+	// InternalConnectorType returns the internal connector type. See 7.9.2.
+	InternalConnectorType PortConnectorType
 	// ExternalReferenceDesignator returns the port connector external reference designator.
 	ExternalReferenceDesignator string
+	// ExternalConnectorType returns the external connector type. See 7.9.2.
+	ExternalConnectorType PortConnectorType
+	// PortType returns the function of the port. See 7.9.3.
+	PortType PortType
 }
 
 // NewPortConnectorInformation initializes and returns a new `PortConnectorInformation`.
-func NewPortConnectorInformation(s *smbios.Structure) *PortConnectorInformation {
+func NewPortConnectorInformation(s *Structure) *PortConnectorInformation {
 	return &PortConnectorInformation{
 		InternalReferenceDesignator: GetStringOrEmpty(s, 0x04),
+		InternalConnectorType:       PortConnectorType(GetByte(s, 0x05)),
 		ExternalReferenceDesignator: GetStringOrEmpty(s, 0x06),
+		ExternalConnectorType:       PortConnectorType(GetByte(s, 0x07)),
+		PortType:                    PortType(GetByte(s, 0x08)),
 	}
 }
+
+// PortConnectorType represents the port connector type.
+type PortConnectorType int
+
+const (
+	// PortConnectorTypeNone is a port connector type.
+	PortConnectorTypeNone PortConnectorType = 0x00
+	// PortConnectorTypeCentronics is a port connector type.
+	PortConnectorTypeCentronics PortConnectorType = 0x01
+	// PortConnectorTypeMiniCentronics is a port connector type.
+	PortConnectorTypeMiniCentronics PortConnectorType = 0x02
+	// PortConnectorTypeProprietary is a port connector type.
+	PortConnectorTypeProprietary PortConnectorType = 0x03
+	// PortConnectorTypeDB25PinMale is a port connector type.
+	PortConnectorTypeDB25PinMale PortConnectorType = 0x04
+	// PortConnectorTypeDB25PinFemale is a port connector type.
+	PortConnectorTypeDB25PinFemale PortConnectorType = 0x05
+	// PortConnectorTypeDB15PinMale is a port connector type.
+	PortConnectorTypeDB15PinMale PortConnectorType = 0x06
+	// PortConnectorTypeDB15PinFemale is a port connector type.
+	PortConnectorTypeDB15PinFemale PortConnectorType = 0x07
+	// PortConnectorTypeDB9PinMale is a port connector type.
+	PortConnectorTypeDB9PinMale PortConnectorType = 0x08
+	// PortConnectorTypeDB9PinFemale is a port connector type.
+	PortConnectorTypeDB9PinFemale PortConnectorType = 0x09
+	// PortConnectorTypeRJ11 is a port connector type.
+	PortConnectorTypeRJ11 PortConnectorType = 0x0A
+	// PortConnectorTypeRJ45 is a port connector type.
+	PortConnectorTypeRJ45 PortConnectorType = 0x0B
+	// PortConnectorTypeMiniSCSI50Pin is a port connector type.
+	PortConnectorTypeMiniSCSI50Pin PortConnectorType = 0x0C
+	// PortConnectorTypeMiniDIN is a port connector type.
+	PortConnectorTypeMiniDIN PortConnectorType = 0x0D
+	// PortConnectorTypeMicroDIN is a port connector type.
+	PortConnectorTypeMicroDIN PortConnectorType = 0x0E
+	// PortConnectorTypePS2 is a port connector type.
+	PortConnectorTypePS2 PortConnectorType = 0x0F
+	// PortConnectorTypeInfrared is a port connector type.
+	PortConnectorTypeInfrared PortConnectorType = 0x10
+	// PortConnectorTypeHPHIL is a port connector type.
+	PortConnectorTypeHPHIL PortConnectorType = 0x11
+	// PortConnectorTypeAccessBusUSB is a port connector type.
+	PortConnectorTypeAccessBusUSB PortConnectorType = 0x12
+	// PortConnectorTypeSSASCSI is a port connector type.
+	PortConnectorTypeSSASCSI PortConnectorType = 0x13
+	// PortConnectorTypeCircularDIN8Male is a port connector type.
+	PortConnectorTypeCircularDIN8Male PortConnectorType = 0x14
+	// PortConnectorTypeCircularDIN8Female is a port connector type.
+	PortConnectorTypeCircularDIN8Female PortConnectorType = 0x15
+	// PortConnectorTypeOnBoardIDE is a port connector type.
+	PortConnectorTypeOnBoardIDE PortConnectorType = 0x16
+	// PortConnectorTypeOnBoardFloppy is a port connector type.
+	PortConnectorTypeOnBoardFloppy PortConnectorType = 0x17
+	// PortConnectorTypeDualInline9Pin is a port connector type.
+	PortConnectorTypeDualInline9Pin PortConnectorType = 0x18
+	// PortConnectorTypeDualInline25Pin is a port connector type.
+	PortConnectorTypeDualInline25Pin PortConnectorType = 0x19
+	// PortConnectorTypeDualInline50Pin is a port connector type.
+	PortConnectorTypeDualInline50Pin PortConnectorType = 0x1A
+	// PortConnectorTypeDualInline68Pin is a port connector type.
+	PortConnectorTypeDualInline68Pin PortConnectorType = 0x1B
+	// PortConnectorTypeOnBoardSoundInputFromCDROM is a port connector type.
+	PortConnectorTypeOnBoardSoundInputFromCDROM PortConnectorType = 0x1C
+	// PortConnectorTypeMiniCentronicsType14 is a port connector type.
+	PortConnectorTypeMiniCentronicsType14 PortConnectorType = 0x1D
+	// PortConnectorTypeMiniCentronicsType26 is a port connector type.
+	PortConnectorTypeMiniCentronicsType26 PortConnectorType = 0x1E
+	// PortConnectorTypeMiniJack is a port connector type.
+	PortConnectorTypeMiniJack PortConnectorType = 0x1F
+	// PortConnectorTypeBNC is a port connector type.
+	PortConnectorTypeBNC PortConnectorType = 0x20
+	// PortConnectorTypeIEEE1394 is a port connector type.
+	PortConnectorTypeIEEE1394 PortConnectorType = 0x21
+	// PortConnectorTypeSASSATAPlugReceptacle is a port connector type.
+	PortConnectorTypeSASSATAPlugReceptacle PortConnectorType = 0x22
+	// PortConnectorTypeUSBTypeCReceptacle is a port connector type.
+	PortConnectorTypeUSBTypeCReceptacle PortConnectorType = 0x23
+	// PortConnectorTypePC98 is a port connector type.
+	PortConnectorTypePC98 PortConnectorType = 0xA0
+	// PortConnectorTypePC98Hireso is a port connector type.
+	PortConnectorTypePC98Hireso PortConnectorType = 0xA1
+	// PortConnectorTypePCH98 is a port connector type.
+	PortConnectorTypePCH98 PortConnectorType = 0xA2
+	// PortConnectorTypePC98Note is a port connector type.
+	PortConnectorTypePC98Note PortConnectorType = 0xA3
+	// PortConnectorTypePC98Full is a port connector type.
+	PortConnectorTypePC98Full PortConnectorType = 0xA4
+	// PortConnectorTypeOther is a port connector type.
+	PortConnectorTypeOther PortConnectorType = 0xFF
+)
+
+// String returns the string representation of a `PortConnectorType`.
+func (p PortConnectorType) String() string {
+	if s, ok := portConnectorTypes[p]; ok {
+		return s
+	}
+
+	return _Unknown
+}
+
+var portConnectorTypes = map[PortConnectorType]string{
+	PortConnectorTypeNone:                       "None",
+	PortConnectorTypeCentronics:                 "Centronics",
+	PortConnectorTypeMiniCentronics:             "Mini Centronics",
+	PortConnectorTypeProprietary:                "Proprietary",
+	PortConnectorTypeDB25PinMale:                "DB-25 pin male",
+	PortConnectorTypeDB25PinFemale:              "DB-25 pin female",
+	PortConnectorTypeDB15PinMale:                "DB-15 pin male",
+	PortConnectorTypeDB15PinFemale:              "DB-15 pin female",
+	PortConnectorTypeDB9PinMale:                 "DB-9 pin male",
+	PortConnectorTypeDB9PinFemale:               "DB-9 pin female",
+	PortConnectorTypeRJ11:                       "RJ-11",
+	PortConnectorTypeRJ45:                       "RJ-45",
+	PortConnectorTypeMiniSCSI50Pin:              "50-pin MiniSCSI",
+	PortConnectorTypeMiniDIN:                    "Mini-DIN",
+	PortConnectorTypeMicroDIN:                   "Micro-DIN",
+	PortConnectorTypePS2:                        "PS/2",
+	PortConnectorTypeInfrared:                   "Infrared",
+	PortConnectorTypeHPHIL:                      "HP-HIL",
+	PortConnectorTypeAccessBusUSB:               "Access Bus (USB)",
+	PortConnectorTypeSSASCSI:                    "SSA SCSI",
+	PortConnectorTypeCircularDIN8Male:           "Circular DIN-8 male",
+	PortConnectorTypeCircularDIN8Female:         "Circular DIN-8 female",
+	PortConnectorTypeOnBoardIDE:                 "On Board IDE",
+	PortConnectorTypeOnBoardFloppy:              "On Board Floppy",
+	PortConnectorTypeDualInline9Pin:             "9-pin Dual Inline (pin 10 cut)",
+	PortConnectorTypeDualInline25Pin:            "25-pin Dual Inline (pin 26 cut)",
+	PortConnectorTypeDualInline50Pin:            "50-pin Dual Inline",
+	PortConnectorTypeDualInline68Pin:            "68-pin Dual Inline",
+	PortConnectorTypeOnBoardSoundInputFromCDROM: "On Board Sound Input from CD-ROM",
+	PortConnectorTypeMiniCentronicsType14:       "Mini-Centronics Type-14",
+	PortConnectorTypeMiniCentronicsType26:       "Mini-Centronics Type-26",
+	PortConnectorTypeMiniJack:                   "Mini-jack (headphones)",
+	PortConnectorTypeBNC:                        "BNC",
+	PortConnectorTypeIEEE1394:                   "1394",
+	PortConnectorTypeSASSATAPlugReceptacle:      "SAS/SATA Plug Receptacle",
+	PortConnectorTypeUSBTypeCReceptacle:         "USB Type-C Receptacle",
+	PortConnectorTypePC98:                       "PC-98",
+	PortConnectorTypePC98Hireso:                 "PC-98Hireso",
+	PortConnectorTypePCH98:                      "PC-H98",
+	PortConnectorTypePC98Note:                   "PC-98Note",
+	PortConnectorTypePC98Full:                   "PC-98Full",
+	PortConnectorTypeOther:                      _Other,
+}
+
+// PortType represents the port type.
+type PortType int
+
+const (
+	// PortTypeNone is a port type.
+	PortTypeNone PortType = 0x00
+	// PortTypeParallelPortXTATCompatible is a port type.
+	PortTypeParallelPortXTATCompatible PortType = 0x01
+	// PortTypeParallelPortPS2 is a port type.
+	PortTypeParallelPortPS2 PortType = 0x02
+	// PortTypeParallelPortECP is a port type.
+	PortTypeParallelPortECP PortType = 0x03
+	// PortTypeParallelPortEPP is a port type.
+	PortTypeParallelPortEPP PortType = 0x04
+	// PortTypeParallelPortECPEPP is a port type.
+	PortTypeParallelPortECPEPP PortType = 0x05
+	// PortTypeSerialPortXTATCompatible is a port type.
+	PortTypeSerialPortXTATCompatible PortType = 0x06
+	// PortTypeSerialPort16450Compatible is a port type.
+	PortTypeSerialPort16450Compatible PortType = 0x07
+	// PortTypeSerialPort16550Compatible is a port type.
+	PortTypeSerialPort16550Compatible PortType = 0x08
+	// PortTypeSerialPort16550ACompatible is a port type.
+	PortTypeSerialPort16550ACompatible PortType = 0x09
+	// PortTypeSCSIPort is a port type.
+	PortTypeSCSIPort PortType = 0x0A
+	// PortTypeMIDIPort is a port type.
+	PortTypeMIDIPort PortType = 0x0B
+	// PortTypeJoyStickPort is a port type.
+	PortTypeJoyStickPort PortType = 0x0C
+	// PortTypeKeyboardPort is a port type.
+	PortTypeKeyboardPort PortType = 0x0D
+	// PortTypeMousePort is a port type.
+	PortTypeMousePort PortType = 0x0E
+	// PortTypeSSASCSI is a port type.
+	PortTypeSSASCSI PortType = 0x0F
+	// PortTypeUSB is a port type.
+	PortTypeUSB PortType = 0x10
+	// PortTypeFireWire is a port type.
+	PortTypeFireWire PortType = 0x11
+	// PortTypePCMCIATypeI is a port type.
+	PortTypePCMCIATypeI PortType = 0x12
+	// PortTypePCMCIATypeII is a port type.
+	PortTypePCMCIATypeII PortType = 0x13
+	// PortTypePCMCIATypeIII is a port type.
+	PortTypePCMCIATypeIII PortType = 0x14
+	// PortTypeCardbus is a port type.
+	PortTypeCardbus PortType = 0x15
+	// PortTypeAccessBusPort is a port type.
+	PortTypeAccessBusPort PortType = 0x16
+	// PortTypeSCSIII is a port type.
+	PortTypeSCSIII PortType = 0x17
+	// PortTypeSCSIWide is a port type.
+	PortTypeSCSIWide PortType = 0x18
+	// PortTypePC98 is a port type.
+	PortTypePC98 PortType = 0x19
+	// PortTypePC98Hireso is a port type.
+	PortTypePC98Hireso PortType = 0x1A
+	// PortTypePCH98 is a port type.
+	PortTypePCH98 PortType = 0x1B
+	// PortTypeVideoPort is a port type.
+	PortTypeVideoPort PortType = 0x1C
+	// PortTypeAudioPort is a port type.
+	PortTypeAudioPort PortType = 0x1D
+	// PortTypeModemPort is a port type.
+	PortTypeModemPort PortType = 0x1E
+	// PortTypeNetworkPort is a port type.
+	PortTypeNetworkPort PortType = 0x1F
+	// PortTypeSATA is a port type.
+	PortTypeSATA PortType = 0x20
+	// PortTypeSAS is a port type.
+	PortTypeSAS PortType = 0x21
+	// PortTypeMFDP is a port type.
+	PortTypeMFDP PortType = 0x22
+	// PortTypeThunderbolt is a port type.
+	PortTypeThunderbolt PortType = 0x23
+	// PortType8251Compatible is a port type.
+	PortType8251Compatible PortType = 0xA0
+	// PortType8251FIFOCompatible is a port type.
+	PortType8251FIFOCompatible PortType = 0xA1
+	// PortTypeOther is a port type.
+	PortTypeOther PortType = 0xFF
+)
+
+// String returns the string representation of a `PortType`.
+func (p PortType) String() string {
+	if s, ok := portTypes[p]; ok {
+		return s
+	}
+
+	return _Unknown
+}
+
+var portTypes = map[PortType]string{
+	PortTypeNone:                       "None",
+	PortTypeParallelPortXTATCompatible: "Parallel Port XT/AT Compatible",
+	PortTypeParallelPortPS2:            "Parallel Port PS/2",
+	PortTypeParallelPortECP:            "Parallel Port ECP",
+	PortTypeParallelPortEPP:            "Parallel Port EPP",
+	PortTypeParallelPortECPEPP:         "Parallel Port ECP/EPP",
+	PortTypeSerialPortXTATCompatible:   "Serial Port XT/AT Compatible",
+	PortTypeSerialPort16450Compatible:  "Serial Port 16450 Compatible",
+	PortTypeSerialPort16550Compatible:  "Serial Port 16550 Compatible",
+	PortTypeSerialPort16550ACompatible: "Serial Port 16550A Compatible",
+	PortTypeSCSIPort:                   "SCSI Port",
+	PortTypeMIDIPort:                   "MIDI Port",
+	PortTypeJoyStickPort:               "Joy Stick Port",
+	PortTypeKeyboardPort:               "Keyboard Port",
+	PortTypeMousePort:                  "Mouse Port",
+	PortTypeSSASCSI:                    "SSA SCSI",
+	PortTypeUSB:                        "USB",
+	PortTypeFireWire:                   "FireWire (IEEE P1394)",
+	PortTypePCMCIATypeI:                "PCMCIA Type I",
+	PortTypePCMCIATypeII:               "PCMCIA Type II",
+	PortTypePCMCIATypeIII:              "PCMCIA Type III",
+	PortTypeCardbus:                    "Cardbus",
+	PortTypeAccessBusPort:              "Access Bus Port",
+	PortTypeSCSIII:                     "SCSI II",
+	PortTypeSCSIWide:                   "SCSI Wide",
+	PortTypePC98:                       "PC-98",
+	PortTypePC98Hireso:                 "PC-98-Hireso",
+	PortTypePCH98:                      "PC-H98",
+	PortTypeVideoPort:                  "Video Port",
+	PortTypeAudioPort:                  "Audio Port",
+	PortTypeModemPort:                  "Modem Port",
+	PortTypeNetworkPort:                "Network Port",
+	PortTypeSATA:                       "SATA",
+	PortTypeSAS:                        "SAS",
+	PortTypeMFDP:                       "MFDP (Multi-Function Display Port)",
+	PortTypeThunderbolt:                "Thunderbolt",
+	PortType8251Compatible:             "8251 Compatible",
+	PortType8251FIFOCompatible:         "8251 FIFO Compatible",
+	PortTypeOther:                      _Other,
+}
@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smbios
+
+// ProcessorCaches represents the cache hierarchy of a processor.
+// A level is nil if the processor has no cache at that level,
+// or if the referenced cache information structure is missing.
+type ProcessorCaches struct {
+	// L1 returns the primary (Level 1) cache.
+	L1 *ProcessorCache
+	// L2 returns the secondary (Level 2) cache.
+	L2 *ProcessorCache
+	// L3 returns the tertiary (Level 3) cache.
+	L3 *ProcessorCache
+}
+
+// ProcessorCache represents a cache linked to a processor.
+type ProcessorCache struct {
+	// Cache returns the cache information.
+	Cache *CacheInformation
+	// Processors returns the number of processors referencing this cache.
+	Processors int
+}
+
+// Shared returns true if the cache is shared with other processors.
+func (p ProcessorCache) Shared() bool {
+	return p.Processors > 1
+}
+
+// CacheByHandle returns the cache information with the given handle,
+// or nil if there is none.
+func (s *SMBIOS) CacheByHandle(handle CacheHandle) *CacheInformation {
+	if handle == NoCache {
+		return nil
+	}
+
+	for i := range s.CacheInformation {
+		if s.CacheInformation[i].Handle == handle {
+			return &s.CacheInformation[i]
+		}
+	}
+
+	return nil
+}
+
+// ProcessorCaches resolves the cache handles of the given processor
+// to their cache information structures.
+func (s *SMBIOS) ProcessorCaches(p ProcessorInformation) ProcessorCaches {
+	return ProcessorCaches{
+		L1: s.processorCache(p.L1CacheHandle),
+		L2: s.processorCache(p.L2CacheHandle),
+		L3: s.processorCache(p.L3CacheHandle),
+	}
+}
+
+func (s *SMBIOS) processorCache(handle CacheHandle) *ProcessorCache {
+	cache := s.CacheByHandle(handle)
+	if cache == nil {
+		return nil
+	}
+
+	processors := 0
+
+	for _, p := range s.ProcessorInformation {
+		for _, h := range []CacheHandle{p.L1CacheHandle, p.L2CacheHandle, p.L3CacheHandle} {
+			if h == handle {
+				processors++
+
+				break
+			}
+		}
+	}
+
+	return &ProcessorCache{
+		Cache:      cache,
+		Processors: processors,
+	}
+}
@@ -0,0 +1,388 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smbios
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ProcessorID represents the raw processor identification data. See 7.5.3.
+//
+// For x86-class CPUs, the low DWORD holds the EAX value and the high DWORD
+// holds the EDX value returned by the CPUID instruction with EAX set to 1.
+// For ARM64-class CPUs with the Arm64 SoC ID characteristic set, the low
+// DWORD holds the SoC ID version and the high DWORD holds the SoC revision.
+type ProcessorID uint64
+
+// Signature returns the CPUID leaf 1 signature (EAX) of an x86-class CPU.
+func (p ProcessorID) Signature() CPUIDSignature {
+	eax := uint32(p)
+
+	return CPUIDSignature{
+		Stepping:       uint8(eax & 0x0F),
+		Model:          uint8((eax >> 4) & 0x0F),
+		Family:         uint8((eax >> 8) & 0x0F),
+		Type:           uint8((eax >> 12) & 0x03),
+		ExtendedModel:  uint8((eax >> 16) & 0x0F),
+		ExtendedFamily: uint8((eax >> 20) & 0xFF),
+	}
+}
+
+// Features returns the CPUID leaf 1 feature flags (EDX) of an x86-class CPU.
+func (p ProcessorID) Features() CPUIDFeatures {
+	return CPUIDFeatures(uint32(p >> 32))
+}
+
+// SoCID returns the SoC ID of an ARM64-class CPU.
+func (p ProcessorID) SoCID() ArmSoCID {
+	version := uint32(p)
+
+	return ArmSoCID{
+		JEP106Bank: uint8((version >> 24) & 0x7F),
+		JEP106Code: uint8((version >> 16) & 0x7F),
+		SoCID:      uint16(version),
+		Revision:   uint32(p >> 32),
+	}
+}
+
+// String returns the string representation of a `ProcessorID`,
+// as the 8 raw bytes in memory order.
+func (p ProcessorID) String() string {
+	b := make([]string, 0, 8)
+
+	for i := range 8 {
+		b = append(b, fmt.Sprintf("%02X", uint8(p>>(8*i))))
+	}
+
+	return strings.Join(b, " ")
+}
+
+// CPUIDSignature represents the x86 CPUID leaf 1 processor signature.
+type CPUIDSignature struct {
+	// Stepping returns the processor stepping.
+	Stepping uint8
+	// Model returns the processor base model.
+	Model uint8
+	// Family returns the processor base family.
+	Family uint8
+	// Type returns the processor type.
+	Type uint8
+	// ExtendedModel returns the processor extended model.
+	ExtendedModel uint8
+	// ExtendedFamily returns the processor extended family.
+	ExtendedFamily uint8
+}
+
+// DisplayFamily returns the family as computed by software from the
+// base and extended family.
+func (c CPUIDSignature) DisplayFamily() int {
+	if c.Family == 0x0F {
+		return int(c.Family) + int(c.ExtendedFamily)
+	}
+
+	return int(c.Family)
+}
+
+// DisplayModel returns the model as computed by software from the
+// base and extended model.
+func (c CPUIDSignature) DisplayModel() int {
+	if c.Family == 0x06 || c.Family == 0x0F {
+		return int(c.ExtendedModel)<<4 + int(c.Model)
+	}
+
+	return int(c.Model)
+}
+
+// String returns the string representation of a `CPUIDSignature`.
+func (c CPUIDSignature) String() string {
+	return fmt.Sprintf("Type %d, Family %d, Model %d, Stepping %d", c.Type, c.DisplayFamily(), c.DisplayModel(), c.Stepping)
+}
+
+// CPUIDFeatures represents the x86 CPUID leaf 1 feature flags (EDX).
+type CPUIDFeatures uint32
+
+// cpuidFeatureNames maps EDX bits to their feature flag names.
+var cpuidFeatureNames = [32]string{
+	0:  "FPU",
+	1:  "VME",
+	2:  "DE",
+	3:  "PSE",
+	4:  "TSC",
+	5:  "MSR",
+	6:  "PAE",
+	7:  "MCE",
+	8:  "CX8",
+	9:  "APIC",
+	11: "SEP",
+	12: "MTRR",
+	13: "PGE",
+	14: "MCA",
+	15: "CMOV",
+	16: "PAT",
+	17: "PSE-36",
+	18: "PSN",
+	19: "CLFSH",
+	21: "DS",
+	22: "ACPI",
+	23: "MMX",
+	24: "FXSR",
+	25: "SSE",
+	26: "SSE2",
+	27: "SS",
+	28: "HTT",
+	29: "TM",
+	31: "PBE",
+}
+
+// Has returns true if the feature flag at the given bit is set.
+func (c CPUIDFeatures) Has(bit int) bool {
+	return IsNthBitSet(int(c), bit)
+}
+
+// Flags returns the names of all feature flags that are set.
+func (c CPUIDFeatures) Flags() []string {
+	return _GetBitNames(int(c), cpuidFeatureNames[:])
+}
+
+// String returns the string representation of a `CPUIDFeatures`.
+func (c CPUIDFeatures) String() string {
+	return strings.Join(c.Flags(), " ")
+}
+
+// ArmSoCID represents the SoC ID of an ARM64-class CPU, as returned
+// by the SMCCC_ARCH_SOC_ID call.
+type ArmSoCID struct {
+	// JEP106Bank returns the JEP106 bank index (continuation code count) of the SiP.
+	JEP106Bank uint8
+	// JEP106Code returns the JEP106 identification code of the SiP, without parity.
+	JEP106Code uint8
+	// SoCID returns the implementation defined SoC ID.
+	SoCID uint16
+	// Revision returns the implementation defined SoC revision.
+	Revision uint32
+}
+
+// JEP106 returns the JEP106 ID of the SiP.
+func (a ArmSoCID) JEP106() JEP106ID {
+	return JEP106ID{Bank: int(a.JEP106Bank) + 1, Code: a.JEP106Code}
+}
+
+// Manufacturer returns the name of the SiP, or an empty string if unknown.
+func (a ArmSoCID) Manufacturer() string {
+	return a.JEP106().Manufacturer()
+}
+
+// String returns the string representation of an `ArmSoCID`.
+func (a ArmSoCID) String() string {
+	return fmt.Sprintf("JEP106 %d:0x%02X, SoC ID 0x%04X, Revision 0x%08X", a.JEP106Bank, a.JEP106Code, a.SoCID, a.Revision)
+}
+
+// IsX86 returns true if the processor ID holds a CPUID signature, i.e. the
+// processor family does not belong to another architecture and the processor
+// ID does not hold an Arm SoC ID.
+func (p ProcessorInformation) IsX86() bool {
+	if p.ProcessorCharacteristics.Arm64SoCID() {
+		return false
+	}
+
+	return !p.Family().IsNonX86()
+}
+
+// Microarchitecture returns the microarchitecture name of an Intel or AMD processor,
+// derived from the CPUID signature. Returns an empty string if unknown.
+func (p ProcessorInformation) Microarchitecture() string {
+	if !p.IsX86() {
+		return _Empty
+	}
+
+	sig := p.ProcessorID.Signature()
+
+	switch p.vendor() {
+	case "Intel":
+		return intelMicroarchitecture(sig)
+	case "AMD":
+		return amdMicroarchitecture(sig)
+	}
+
+	return _Empty
+}
+
+func (p ProcessorInformation) vendor() string {
+	manufacturer := strings.ToLower(p.ProcessorManufacturer)
+
+	switch {
+	case strings.Contains(manufacturer, "intel"):
+		return "Intel"
+	case strings.Contains(manufacturer, "amd"), strings.Contains(manufacturer, "advanced micro devices"):
+		return "AMD"
+	}
+
+	return _Empty
+}
+
+// intelFamily6Microarchitectures maps Intel family 6 display models to microarchitectures.
+var intelFamily6Microarchitectures = map[int]string{
+	0x0F: "Core",
+	0x16: "Core",
+	0x17: "Penryn",
+	0x1D: "Penryn",
+	0x1C: "Bonnell",
+	0x26: "Bonnell",
+	0x1A: "Nehalem",
+	0x1E: "Nehalem",
+	0x1F: "Nehalem",
+	0x2E: "Nehalem",
+	0x25: "Westmere",
+	0x2C: "Westmere",
+	0x2F: "Westmere",
+	0x2A: "Sandy Bridge",
+	0x2D: "Sandy Bridge",
+	0x36: "Saltwell",
+	0x3A: "Ivy Bridge",
+	0x3E: "Ivy Bridge",
+	0x37: "Silvermont",
+	0x4A: "Silvermont",
+	0x4D: "Silvermont",
+	0x5A: "Silvermont",
+	0x4C: "Airmont",
+	0x3C: "Haswell",
+	0x3F: "Haswell",
+	0x45: "Haswell",
+	0x46: "Haswell",
+	0x3D: "Broadwell",
+	0x47: "Broadwell",
+	0x4F: "Broadwell",
+	0x56: "Broadwell",
+	0x57: "Knights Landing",
+	0x85: "Knights Mill",
+	0x4E: "Skylake",
+	0x5E: "Skylake",
+	0x5C: "Goldmont",
+	0x5F: "Goldmont",
+	0x7A: "Goldmont Plus",
+	0x8E: "Kaby Lake",
+	0x66: "Cannon Lake",
+	0xA5: "Comet Lake",
+	0xA6: "Comet Lake",
+	0x6A: "Ice Lake",
+	0x6C: "Ice Lake",
+	0x7D: "Ice Lake",
+	0x7E: "Ice Lake",
+	0x86: "Tremont",
+	0x96: "Tremont",
+	0x9C: "Tremont",
+	0x8C: "Tiger Lake",
+	0x8D: "Tiger Lake",
+	0xA7: "Rocket Lake",
+	0x97: "Alder Lake",
+	0x9A: "Alder Lake",
+	0xBE: "Gracemont",
+	0xB7: "Raptor Lake",
+	0xBA: "Raptor Lake",
+	0xBF: "Raptor Lake",
+	0x8F: "Sapphire Rapids",
+	0xCF: "Emerald Rapids",
+	0xAD: "Granite Rapids",
+	0xAE: "Granite Rapids",
+	0xAF: "Sierra Forest",
+	0xAA: "Meteor Lake",
+	0xAC: "Meteor Lake",
+	0xBD: "Lunar Lake",
+	0xC5: "Arrow Lake",
+	0xC6: "Arrow Lake",
+}
+
+func intelMicroarchitecture(sig CPUIDSignature) string {
+	model := sig.DisplayModel()
+
+	switch sig.DisplayFamily() {
+	case 0x05:
+		return "P5"
+	case 0x06:
+		// Some models are shared between microarchitectures and are told apart by stepping.
+		switch {
+		case model == 0x55 && sig.Stepping >= 10:
+			return "Cooper Lake"
+		case model == 0x55 && sig.Stepping >= 5:
+			return "Cascade Lake"
+		case model == 0x55:
+			return "Skylake"
+		case model == 0x9E && sig.Stepping >= 10:
+			return "Coffee Lake"
+		case model == 0x9E:
+			return "Kaby Lake"
+		case model < 0x0F:
+			return "P6"
+		}
+
+		return intelFamily6Microarchitectures[model]
+	case 0x0F:
+		return "NetBurst"
+	}
+
+	return _Empty
+}
+
+//nolint:gocyclo,cyclop
+func amdMicroarchitecture(sig CPUIDSignature) string {
+	model := sig.DisplayModel()
+
+	switch sig.DisplayFamily() {
+	case 0x05:
+		if model >= 0x06 {
+			return "K6"
+		}
+
+		return "K5"
+	case 0x06:
+		return "K7"
+	case 0x0F:
+		return "K8"
+	case 0x10, 0x11, 0x12:
+		return "K10"
+	case 0x14:
+		return "Bobcat"
+	case 0x15:
+		switch {
+		case model <= 0x01:
+			return "Bulldozer"
+		case model == 0x02, model >= 0x10 && model <= 0x1F:
+			return "Piledriver"
+		case model >= 0x30 && model <= 0x3F:
+			return "Steamroller"
+		case model >= 0x60 && model <= 0x7F:
+			return "Excavator"
+		}
+	case 0x16:
+		if model >= 0x30 {
+			return "Puma"
+		}
+
+		return "Jaguar"
+	case 0x17:
+		switch {
+		case model == 0x08, model == 0x18:
+			return "Zen+"
+		case model < 0x30:
+			return "Zen"
+		}
+
+		return "Zen 2"
+	case 0x19:
+		switch {
+		case model >= 0x10 && model <= 0x1F, model >= 0x60 && model <= 0x7F, model >= 0xA0 && model <= 0xAF:
+			return "Zen 4"
+		case model >= 0x40 && model <= 0x4F:
+			return "Zen 3+"
+		}
+
+		return "Zen 3"
+	case 0x1A:
+		return "Zen 5"
+	}
+
+	return _Empty
+}
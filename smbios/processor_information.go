@@ -4,18 +4,36 @@
 
 package smbios
 
-import "github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
+import (
+	"fmt"
+	"strings"
+)
 
 // ProcessorInformation represents the SMBIOS process information.
 //
 //nolint:govet
 type ProcessorInformation struct {
+	// Handle returns the structure handle.
+	Handle uint16
 	// SocketDesignation returns the processor socket designation.
 	SocketDesignation string
+	// ProcessorType returns the processor type. See 7.5.1.
+	ProcessorType ProcessorType
+	// ProcessorFamily returns the processor family. See 7.5.2.
+	// If the value is FEh, the family is stored in the
+	// Processor Family 2 field; use `Family` to get the effective value.
+	ProcessorFamily ProcessorFamily
 	// ProcessorManufacturer returns the processor manufacturer.
 	ProcessorManufacturer string
+	// ProcessorID returns the raw processor identification data. See 7.5.3.
+	ProcessorID ProcessorID
 	// ProcessorVersion returns the processor version.
 	ProcessorVersion string
+	// Voltage returns the voltage of the processor. See 7.5.4.
+	Voltage ProcessorVoltage
+	// ExternalClock returns the external clock frequency, in MHz.
+	// If the value is unknown, the field is set to 0.
+	ExternalClock uint16
 	// MaxSpeed returns the maximum speed of the processor,
 	// in MHz, supported by the system for this processor socket.
 	// If the value is unknown, the field is set to 0.
@@ -31,6 +49,17 @@ type ProcessorInformation struct {
 	CurrentSpeed uint16
 	// ProcessorStatus returns the processor status.
 	Status ProcessorStatus
+	// ProcessorUpgrade returns the processor upgrade, or socket. See 7.5.5.
+	ProcessorUpgrade ProcessorUpgrade
+	// L1CacheHandle returns the handle of the primary (Level 1)
+	// cache information structure for this processor.
+	L1CacheHandle CacheHandle
+	// L2CacheHandle returns the handle of the secondary (Level 2)
+	// cache information structure for this processor.
+	L2CacheHandle CacheHandle
+	// L3CacheHandle returns the handle of the tertiary (Level 3)
+	// cache information structure for this processor.
+	L3CacheHandle CacheHandle
 	// SerialNumber returns the processor serial number.
 	SerialNumber string
 	// AssetTag returns the processor asset tag.
@@ -43,24 +72,205 @@ type ProcessorInformation struct {
 	CoreEnabled uint8
 	// ThreadCount returns the processor's number of threads.
 	ThreadCount uint8
+	// ProcessorCharacteristics returns the processor supported functions. See 7.5.9.
+	ProcessorCharacteristics ProcessorCharacteristics
+	// ProcessorFamily2 returns the processor family when the
+	// Processor Family field is set to FEh. See 7.5.2.
+	ProcessorFamily2 ProcessorFamily
+	// CoreCount2 returns the processor's number of cores,
+	// for processors with more than 255 cores.
+	CoreCount2 uint16
+	// CoreEnabled2 returns the processor's number of enabled cores,
+	// for processors with more than 255 enabled cores.
+	CoreEnabled2 uint16
+	// ThreadCount2 returns the processor's number of threads,
+	// for processors with more than 255 threads.
+	ThreadCount2 uint16
+	// ThreadEnabled returns the processor's number of enabled threads.
+	ThreadEnabled uint16
+	// SocketType returns the processor socket type.
+	SocketType string
+
+	// FieldPresence returns the fields that the structure was too short to contain.
+	FieldPresence
 }
 
 // NewProcessorInformation initializes and returns a new `ProcessorInformation`.
-func NewProcessorInformation(s *smbios.Structure) *ProcessorInformation {
+func NewProcessorInformation(s *Structure) *ProcessorInformation {
 	return &ProcessorInformation{
-		SocketDesignation:     GetStringOrEmpty(s, 0x04),
-		ProcessorManufacturer: GetStringOrEmpty(s, 0x07),
-		ProcessorVersion:      GetStringOrEmpty(s, 0x10),
-		MaxSpeed:              GetWord(s, 0x14),
-		CurrentSpeed:          GetWord(s, 0x16),
-		Status:                ProcessorStatus(GetByte(s, 0x18)),
-		SerialNumber:          GetStringOrEmpty(s, 0x20),
-		AssetTag:              GetStringOrEmpty(s, 0x21),
-		PartNumber:            GetStringOrEmpty(s, 0x22),
-		CoreCount:             GetByte(s, 0x23),
-		CoreEnabled:           GetByte(s, 0x24),
-		ThreadCount:           GetByte(s, 0x25),
+		Handle:                   s.Header.Handle,
+		SocketDesignation:        GetStringOrEmpty(s, 0x04),
+		ProcessorType:            ProcessorType(GetByte(s, 0x05)),
+		ProcessorFamily:          ProcessorFamily(GetByte(s, 0x06)),
+		ProcessorManufacturer:    GetStringOrEmpty(s, 0x07),
+		ProcessorID:              ProcessorID(GetQWord(s, 0x08)),
+		ProcessorVersion:         GetStringOrEmpty(s, 0x10),
+		Voltage:                  ProcessorVoltage(GetByte(s, 0x11)),
+		ExternalClock:            GetWord(s, 0x12),
+		MaxSpeed:                 GetWord(s, 0x14),
+		CurrentSpeed:             GetWord(s, 0x16),
+		Status:                   ProcessorStatus(GetByte(s, 0x18)),
+		ProcessorUpgrade:         ProcessorUpgrade(GetByte(s, 0x19)),
+		L1CacheHandle:            CacheHandle(GetWord(s, 0x1A)),
+		L2CacheHandle:            CacheHandle(GetWord(s, 0x1C)),
+		L3CacheHandle:            CacheHandle(GetWord(s, 0x1E)),
+		SerialNumber:             GetStringOrEmpty(s, 0x20),
+		AssetTag:                 GetStringOrEmpty(s, 0x21),
+		PartNumber:               GetStringOrEmpty(s, 0x22),
+		CoreCount:                GetByte(s, 0x23),
+		CoreEnabled:              GetByte(s, 0x24),
+		ThreadCount:              GetByte(s, 0x25),
+		ProcessorCharacteristics: ProcessorCharacteristics(GetWord(s, 0x26)),
+		ProcessorFamily2:         ProcessorFamily(GetWord(s, 0x28)),
+		CoreCount2:               GetWord(s, 0x2A),
+		CoreEnabled2:             GetWord(s, 0x2C),
+		ThreadCount2:             GetWord(s, 0x2E),
+		ThreadEnabled:            GetWord(s, 0x30),
+		SocketType:               GetStringOrEmpty(s, 0x32),
+		FieldPresence:            _GetFieldPresence(s),
+	}
+}
+
+// MarshalJSON implements `json.Marshaler`, encoding absent fields as null.
+func (p ProcessorInformation) MarshalJSON() ([]byte, error) {
+	return _MarshalJSONWithPresence(p, p.FieldPresence)
+}
+
+// Family returns the effective processor family, taking the
+// Processor Family 2 field into account.
+func (p ProcessorInformation) Family() ProcessorFamily {
+	if p.ProcessorFamily == ProcessorFamilyIndicatorFamily2 {
+		return p.ProcessorFamily2
+	}
+
+	return p.ProcessorFamily
+}
+
+// FamilyName returns the name of the effective processor family.
+// The ambiguous value BEh is resolved using the processor manufacturer.
+func (p ProcessorInformation) FamilyName() string {
+	family := p.Family()
+
+	if family == ProcessorFamilyCore2OrK7 {
+		switch {
+		case strings.Contains(p.ProcessorManufacturer, "Intel"):
+			return "Core 2"
+		case strings.Contains(p.ProcessorManufacturer, "AMD"):
+			return "K7"
+		}
+	}
+
+	return family.String()
+}
+
+// Cores returns the number of cores, taking Core Count 2 into account.
+func (p ProcessorInformation) Cores() int {
+	return _GetCount(p.CoreCount, p.CoreCount2)
+}
+
+// EnabledCores returns the number of enabled cores, taking Core Enabled 2 into account.
+func (p ProcessorInformation) EnabledCores() int {
+	return _GetCount(p.CoreEnabled, p.CoreEnabled2)
+}
+
+// Threads returns the number of threads, taking Thread Count 2 into account.
+func (p ProcessorInformation) Threads() int {
+	return _GetCount(p.ThreadCount, p.ThreadCount2)
+}
+
+// _GetCount returns the extended count when the legacy byte count is FFh.
+func _GetCount(count uint8, count2 uint16) int {
+	if count == 0xFF && count2 != 0 && count2 != 0xFFFF {
+		return int(count2)
+	}
+
+	return int(count)
+}
+
+// ProcessorType represents the processor type.
+type ProcessorType int
+
+const (
+	// ProcessorTypeOther is a processor type.
+	ProcessorTypeOther ProcessorType = iota + 1
+	// ProcessorTypeUnknown is a processor type.
+	ProcessorTypeUnknown
+	// ProcessorTypeCentralProcessor is a processor type.
+	ProcessorTypeCentralProcessor
+	// ProcessorTypeMathProcessor is a processor type.
+	ProcessorTypeMathProcessor
+	// ProcessorTypeDSPProcessor is a processor type.
+	ProcessorTypeDSPProcessor
+	// ProcessorTypeVideoProcessor is a processor type.
+	ProcessorTypeVideoProcessor
+)
+
+// String returns the string representation of a `ProcessorType`.
+func (p ProcessorType) String() string {
+	switch p {
+	case ProcessorTypeOther:
+		return _Other
+	case ProcessorTypeUnknown:
+		return _Unknown
+	case ProcessorTypeCentralProcessor:
+		return "Central Processor"
+	case ProcessorTypeMathProcessor:
+		return "Math Processor"
+	case ProcessorTypeDSPProcessor:
+		return "DSP Processor"
+	case ProcessorTypeVideoProcessor:
+		return "Video Processor"
+	}
+
+	return _Unknown
+}
+
+// ProcessorVoltage represents the processor voltage.
+// If bit 7 is cleared, bits 3:0 hold the legacy supported voltages.
+// If bit 7 is set, bits 6:0 hold the current voltage times 10.
+type ProcessorVoltage uint8
+
+// IsLegacy returns true if the voltage is encoded in legacy mode.
+func (p ProcessorVoltage) IsLegacy() bool {
+	return !IsNthBitSet(int(p), 7)
+}
+
+// Voltages returns the voltages, in volts, encoded in this value.
+// In legacy mode, all supported voltages are returned.
+func (p ProcessorVoltage) Voltages() []float32 {
+	if !p.IsLegacy() {
+		if p&0x7F == 0 {
+			return nil
+		}
+
+		return []float32{float32(p&0x7F) / 10}
+	}
+
+	var voltages []float32
+
+	for bit, voltage := range []float32{5.0, 3.3, 2.9} {
+		if IsNthBitSet(int(p), bit) {
+			voltages = append(voltages, voltage)
+		}
+	}
+
+	return voltages
+}
+
+// String returns the string representation of a `ProcessorVoltage`.
+func (p ProcessorVoltage) String() string {
+	voltages := p.Voltages()
+	if len(voltages) == 0 {
+		return _Unknown
 	}
+
+	s := make([]string, 0, len(voltages))
+
+	for _, voltage := range voltages {
+		s = append(s, fmt.Sprintf("%.1f V", voltage))
+	}
+
+	return strings.Join(s, " ")
 }
 
 // ProcessorStatus represents the processor status.
@@ -70,3 +280,215 @@ type ProcessorStatus int
 func (s ProcessorStatus) SocketPopulated() bool {
 	return IsNthBitSet(int(s), 6)
 }
+
+// CPUStatus returns the CPU status.
+func (s ProcessorStatus) CPUStatus() ProcessorCPUStatus {
+	return ProcessorCPUStatus(s & 0x07)
+}
+
+// ProcessorCPUStatus represents the CPU status.
+type ProcessorCPUStatus int
+
+const (
+	// ProcessorCPUStatusUnknown is a CPU status.
+	ProcessorCPUStatusUnknown ProcessorCPUStatus = iota
+	// ProcessorCPUStatusEnabled is a CPU status.
+	ProcessorCPUStatusEnabled
+	// ProcessorCPUStatusDisabledByUser is a CPU status.
+	ProcessorCPUStatusDisabledByUser
+	// ProcessorCPUStatusDisabledByBIOS is a CPU status.
+	ProcessorCPUStatusDisabledByBIOS
+	// ProcessorCPUStatusIdle is a CPU status.
+	ProcessorCPUStatusIdle
+	// ProcessorCPUStatusOther is a CPU status.
+	ProcessorCPUStatusOther ProcessorCPUStatus = 7
+)
+
+// String returns the string representation of a `ProcessorCPUStatus`.
+func (p ProcessorCPUStatus) String() string {
+	switch p {
+	case ProcessorCPUStatusUnknown:
+		return _Unknown
+	case ProcessorCPUStatusEnabled:
+		return "Enabled"
+	case ProcessorCPUStatusDisabledByUser:
+		return "Disabled By User"
+	case ProcessorCPUStatusDisabledByBIOS:
+		return "Disabled By BIOS"
+	case ProcessorCPUStatusIdle:
+		return "Idle"
+	case ProcessorCPUStatusOther:
+		return _Other
+	}
+
+	return _Reserved
+}
+
+// ProcessorCharacteristics represents the processor characteristics.
+type ProcessorCharacteristics uint16
+
+// Unknown returns true if the processor characteristics are unknown.
+func (p ProcessorCharacteristics) Unknown() bool {
+	return IsNthBitSet(int(p), 1)
+}
+
+// Is64BitCapable returns true if the processor is 64-bit capable.
+func (p ProcessorCharacteristics) Is64BitCapable() bool {
+	return IsNthBitSet(int(p), 2)
+}
+
+// MultiCore returns true if the processor has more than one core.
+func (p ProcessorCharacteristics) MultiCore() bool {
+	return IsNthBitSet(int(p), 3)
+}
+
+// HardwareThread returns true if the processor supports multiple hardware threads per core.
+func (p ProcessorCharacteristics) HardwareThread() bool {
+	return IsNthBitSet(int(p), 4)
+}
+
+// ExecuteProtection returns true if the processor supports execute protection.
+func (p ProcessorCharacteristics) ExecuteProtection() bool {
+	return IsNthBitSet(int(p), 5)
+}
+
+// EnhancedVirtualization returns true if the processor supports enhanced virtualization.
+func (p ProcessorCharacteristics) EnhancedVirtualization() bool {
+	return IsNthBitSet(int(p), 6)
+}
+
+// PowerPerformanceControl returns true if the processor supports power/performance control.
+func (p ProcessorCharacteristics) PowerPerformanceControl() bool {
+	return IsNthBitSet(int(p), 7)
+}
+
+// Is128BitCapable returns true if the processor is 128-bit capable.
+func (p ProcessorCharacteristics) Is128BitCapable() bool {
+	return IsNthBitSet(int(p), 8)
+}
+
+// Arm64SoCID returns true if the Processor ID field contains an Arm SoC ID.
+func (p ProcessorCharacteristics) Arm64SoCID() bool {
+	return IsNthBitSet(int(p), 9)
+}
+
+// String returns the string representation of a `ProcessorCharacteristics`.
+func (p ProcessorCharacteristics) String() string {
+	var s []string
+
+	for _, c := range []struct {
+		set  bool
+		name string
+	}{
+		{p.Unknown(), _Unknown},
+		{p.Is64BitCapable(), "64-bit capable"},
+		{p.MultiCore(), "Multi-Core"},
+		{p.HardwareThread(), "Hardware Thread"},
+		{p.ExecuteProtection(), "Execute Protection"},
+		{p.EnhancedVirtualization(), "Enhanced Virtualization"},
+		{p.PowerPerformanceControl(), "Power/Performance Control"},
+		{p.Is128BitCapable(), "128-bit Capable"},
+		{p.Arm64SoCID(), "Arm64 SoC ID"},
+	} {
+		if c.set {
+			s = append(s, c.name)
+		}
+	}
+
+	return strings.Join(s, ", ")
+}
+
+// ProcessorUpgrade represents the processor upgrade, or socket.
+type ProcessorUpgrade int
+
+// String returns the string representation of a `ProcessorUpgrade`.
+func (p ProcessorUpgrade) String() string {
+	if s, ok := processorUpgrades[p]; ok {
+		return s
+	}
+
+	return _Unknown
+}
+
+var processorUpgrades = map[ProcessorUpgrade]string{
+	0x01: _Other,
+	0x02: _Unknown,
+	0x03: "Daughter Board",
+	0x04: "ZIF Socket",
+	0x05: "Replaceable Piggy Back",
+	0x06: "None",
+	0x07: "LIF Socket",
+	0x08: "Slot 1",
+	0x09: "Slot 2",
+	0x0A: "370-pin socket",
+	0x0B: "Slot A",
+	0x0C: "Slot M",
+	0x0D: "Socket 423",
+	0x0E: "Socket A (Socket 462)",
+	0x0F: "Socket 478",
+	0x10: "Socket 754",
+	0x11: "Socket 940",
+	0x12: "Socket 939",
+	0x13: "Socket mPGA604",
+	0x14: "Socket LGA771",
+	0x15: "Socket LGA775",
+	0x16: "Socket S1",
+	0x17: "Socket AM2",
+	0x18: "Socket F (1207)",
+	0x19: "Socket LGA1366",
+	0x1A: "Socket G34",
+	0x1B: "Socket AM3",
+	0x1C: "Socket C32",
+	0x1D: "Socket LGA1156",
+	0x1E: "Socket LGA1567",
+	0x1F: "Socket PGA988A",
+	0x20: "Socket BGA1288",
+	0x21: "Socket rPGA988B",
+	0x22: "Socket BGA1023",
+	0x23: "Socket BGA1224",
+	0x24: "Socket LGA1155",
+	0x25: "Socket LGA1356",
+	0x26: "Socket LGA2011",
+	0x27: "Socket FS1",
+	0x28: "Socket FS2",
+	0x29: "Socket FM1",
+	0x2A: "Socket FM2",
+	0x2B: "Socket LGA2011-3",
+	0x2C: "Socket LGA1356-3",
+	0x2D: "Socket LGA1150",
+	0x2E: "Socket BGA1168",
+	0x2F: "Socket BGA1234",
+	0x30: "Socket BGA1364",
+	0x31: "Socket AM4",
+	0x32: "Socket LGA1151",
+	0x33: "Socket BGA1356",
+	0x34: "Socket BGA1440",
+	0x35: "Socket BGA1515",
+	0x36: "Socket LGA3647-1",
+	0x37: "Socket SP3",
+	0x38: "Socket SP3r2",
+	0x39: "Socket LGA2066",
+	0x3A: "Socket BGA1392",
+	0x3B: "Socket BGA1510",
+	0x3C: "Socket BGA1528",
+	0x3D: "Socket LGA4189",
+	0x3E: "Socket LGA1200",
+	0x3F: "Socket LGA4677",
+	0x40: "Socket LGA1700",
+	0x41: "Socket BGA1744",
+	0x42: "Socket BGA1781",
+	0x43: "Socket BGA1211",
+	0x44: "Socket BGA2422",
+	0x45: "Socket LGA1211",
+	0x46: "Socket LGA2422",
+	0x47: "Socket LGA5773",
+	0x48: "Socket BGA5773",
+	0x49: "Socket AM5",
+	0x4A: "Socket SP5",
+	0x4B: "Socket SP6",
+	0x4C: "Socket BGA883",
+	0x4D: "Socket BGA1190",
+	0x4E: "Socket BGA4129",
+	0x4F: "Socket LGA4710",
+	0x50: "Socket LGA7529",
+}
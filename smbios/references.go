@@ -0,0 +1,299 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smbios
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// Reference represents a handle reference from one structure to another.
+type Reference struct {
+	// From returns the handle of the referencing structure.
+	From uint16
+	// FromType returns the type of the referencing structure.
+	FromType uint8
+	// Field returns the name of the field holding the reference, as in the specification.
+	Field string
+	// To returns the referenced handle.
+	To uint16
+	// Dangling returns true if no structure has the referenced handle.
+	Dangling bool
+}
+
+// String returns the string representation of a `Reference`.
+func (r Reference) String() string {
+	return fmt.Sprintf("0x%04X %s -> 0x%04X", r.From, r.Field, r.To)
+}
+
+// HandleIndex maps handles to structures, for repeated lookups by handle.
+type HandleIndex map[uint16]*Structure
+
+// NewHandleIndex indexes the given structures by handle. If the firmware
+// reuses a handle, the first structure with that handle is kept.
+func NewHandleIndex(structures []*Structure) HandleIndex {
+	index := make(HandleIndex, len(structures))
+
+	for _, structure := range structures {
+		if _, ok := index[structure.Header.Handle]; !ok {
+			index[structure.Header.Handle] = structure
+		}
+	}
+
+	return index
+}
+
+// ByHandle returns the structure with the given handle, or nil if there is none.
+func (s *SMBIOS) ByHandle(handle uint16) *Structure {
+	for _, structure := range s.Structures {
+		if structure.Header.Handle == handle {
+			return structure
+		}
+	}
+
+	return nil
+}
+
+// References returns the outgoing references of the structure with the given handle.
+func (s *SMBIOS) References(handle uint16) []Reference {
+	structure := s.ByHandle(handle)
+	if structure == nil {
+		return nil
+	}
+
+	return _GetReferences(structure, NewHandleIndex(s.Structures))
+}
+
+// ReferencedBy returns the incoming references to the structure with the given handle.
+func (s *SMBIOS) ReferencedBy(handle uint16) []Reference {
+	var references []Reference
+
+	for _, reference := range s.AllReferences() {
+		if reference.To == handle {
+			references = append(references, reference)
+		}
+	}
+
+	return references
+}
+
+// AllReferences returns the references of all structures, in table order.
+func (s *SMBIOS) AllReferences() []Reference {
+	var references []Reference
+
+	index := NewHandleIndex(s.Structures)
+
+	for _, structure := range s.Structures {
+		references = append(references, _GetReferences(structure, index)...)
+	}
+
+	return references
+}
+
+// DanglingReferences returns the references to handles that no structure has.
+func (s *SMBIOS) DanglingReferences() []Reference {
+	var references []Reference
+
+	for _, reference := range s.AllReferences() {
+		if reference.Dangling {
+			references = append(references, reference)
+		}
+	}
+
+	return references
+}
+
+// WriteDOT writes the reference graph of the table in the Graphviz DOT format.
+// Dangling references point to dashed red nodes.
+func (s *SMBIOS) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "digraph smbios {")
+	fmt.Fprintln(bw, "\tnode [shape=box];")
+
+	for _, structure := range s.Structures {
+		fmt.Fprintf(bw, "\t\"0x%04X\" [label=\"%s\\n0x%04X\"];\n",
+			structure.Header.Handle, StructureTypeName(structure.Header.Type), structure.Header.Handle)
+	}
+
+	dangling := map[uint16]struct{}{}
+
+	for _, reference := range s.AllReferences() {
+		if _, ok := dangling[reference.To]; reference.Dangling && !ok {
+			dangling[reference.To] = struct{}{}
+
+			fmt.Fprintf(bw, "\t\"0x%04X\" [label=\"missing\\n0x%04X\", style=dashed, color=red];\n", reference.To, reference.To)
+		}
+
+		fmt.Fprintf(bw, "\t\"0x%04X\" -> \"0x%04X\" [label=%q];\n", reference.From, reference.To, reference.Field)
+	}
+
+	fmt.Fprintln(bw, "}")
+
+	return bw.Flush()
+}
+
+// _GetReferences returns the outgoing references of the given structure,
+// resolving the referenced handles with the given index.
+//
+//nolint:gocyclo,cyclop
+func _GetReferences(structure *Structure, index HandleIndex) []Reference {
+	var references []Reference
+
+	add := func(field string, offset int) {
+		handle, err := structure.Word(offset)
+		// FFFFh means no reference, and FFFEh that the information is not provided.
+		if err != nil || handle == 0xFFFF || handle == 0xFFFE {
+			return
+		}
+
+		references = append(references, Reference{
+			From:     structure.Header.Handle,
+			FromType: structure.Header.Type,
+			Field:    field,
+			To:       handle,
+			Dangling: index[handle] == nil,
+		})
+	}
+
+	addList := func(field string, countOffset, listOffset, stride int) {
+		count, err := structure.Byte(countOffset)
+		if err != nil {
+			return
+		}
+
+		for i := range int(count) {
+			add(field, listOffset+stride*i)
+		}
+	}
+
+	switch structure.Header.Type {
+	case 2:
+		add("Chassis Handle", 0x0B)
+		addList("Contained Object Handles", 0x0E, 0x0F, 2)
+	case 4:
+		add("L1 Cache Handle", 0x1A)
+		add("L2 Cache Handle", 0x1C)
+		add("L3 Cache Handle", 0x1E)
+	case 14:
+		for offset := 0x06; offset+2 <= int(structure.Header.Length); offset += 3 {
+			add("Item Handle", offset)
+		}
+	case 16:
+		add("Memory Error Information Handle", 0x0B)
+	case 17:
+		add("Physical Memory Array Handle", 0x04)
+		add("Memory Error Information Handle", 0x06)
+	case 19:
+		add("Memory Array Handle", 0x0C)
+	case 20:
+		add("Memory Device Handle", 0x0C)
+		add("Memory Array Mapped Address Handle", 0x0E)
+	case 27:
+		add("Temperature Probe Handle", 0x04)
+	case 35:
+		add("Management Device Handle", 0x05)
+		add("Component Handle", 0x07)
+		add("Threshold Handle", 0x09)
+	case 37:
+		addList("Memory Device Handle", 0x06, 0x08, 3)
+	case 39:
+		add("Input Voltage Probe Handle", 0x10)
+		add("Cooling Device Handle", 0x12)
+		add("Input Current Probe Handle", 0x14)
+	case 40:
+		count, err := structure.Byte(0x04)
+		if err != nil {
+			break
+		}
+
+		// Additional information entries have a variable length, stored in their first byte.
+		for offset, i := 0x05, 0; i < int(count); i++ {
+			length, err := structure.Byte(offset)
+			if err != nil || length == 0 {
+				break
+			}
+
+			add("Referenced Handle", offset+1)
+
+			offset += int(length)
+		}
+	case 44:
+		add("Referenced Handle", 0x04)
+	case 45:
+		addList("Associated Component Handles", 0x17, 0x18, 2)
+	case 46:
+		add("Parent Handle", 0x07)
+	}
+
+	return references
+}
+
+// structureTypeNames maps structure types to their names.
+var structureTypeNames = map[uint8]string{
+	0:   "BIOS Information",
+	1:   "System Information",
+	2:   "Baseboard Information",
+	3:   "System Enclosure",
+	4:   "Processor Information",
+	5:   "Memory Controller Information",
+	6:   "Memory Module Information",
+	7:   "Cache Information",
+	8:   "Port Connector Information",
+	9:   "System Slots",
+	10:  "On Board Devices Information",
+	11:  "OEM Strings",
+	12:  "System Configuration Options",
+	13:  "BIOS Language Information",
+	14:  "Group Associations",
+	15:  "System Event Log",
+	16:  "Physical Memory Array",
+	17:  "Memory Device",
+	18:  "32-Bit Memory Error Information",
+	19:  "Memory Array Mapped Address",
+	20:  "Memory Device Mapped Address",
+	21:  "Built-in Pointing Device",
+	22:  "Portable Battery",
+	23:  "System Reset",
+	24:  "Hardware Security",
+	25:  "System Power Controls",
+	26:  "Voltage Probe",
+	27:  "Cooling Device",
+	28:  "Temperature Probe",
+	29:  "Electrical Current Probe",
+	30:  "Out-of-Band Remote Access",
+	31:  "Boot Integrity Services Entry Point",
+	32:  "System Boot Information",
+	33:  "64-Bit Memory Error Information",
+	34:  "Management Device",
+	35:  "Management Device Component",
+	36:  "Management Device Threshold Data",
+	37:  "Memory Channel",
+	38:  "IPMI Device Information",
+	39:  "System Power Supply",
+	40:  "Additional Information",
+	41:  "Onboard Devices Extended Information",
+	42:  "Management Controller Host Interface",
+	43:  "TPM Device",
+	44:  "Processor Additional Information",
+	45:  "Firmware Inventory Information",
+	46:  "String Property",
+	126: "Inactive",
+	127: "End-of-Table",
+}
+
+// StructureTypeName returns the name of the given structure type.
+func StructureTypeName(t uint8) string {
+	if name, ok := structureTypeNames[t]; ok {
+		return name
+	}
+
+	if t >= 128 {
+		return fmt.Sprintf("OEM-specific Type %d", t)
+	}
+
+	return fmt.Sprintf("Unknown Type %d", t)
+}
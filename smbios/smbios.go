@@ -5,7 +5,7 @@
 package smbios
 
 import (
-	"encoding/binary"
+	"bytes"
 	"fmt"
 	"io"
 	"strings"
@@ -23,29 +23,40 @@ type Version struct {
 
 // SMBIOS represents the System Management BIOS.
 type SMBIOS struct { //nolint:govet
-	Version    Version
-	Structures []*smbios.Structure `json:"-"`
+	Version Version
+	// VersionInferred returns true if the version was inferred from the structures,
+	// in the absence of an entry point. See `InferVersion`.
+	VersionInferred bool `json:",omitempty"`
+	// EntryPoint returns the entry point of the table, if known.
+	EntryPoint EntryPoint   `json:"-"`
+	Structures []*Structure `json:"-"`
 
 	BIOSInformation            BIOSInformation
 	SystemInformation          SystemInformation
 	BaseboardInformation       BaseboardInformation
-	SystemEnclosure            SystemEnclosure
+	SystemEnclosure            []SystemEnclosure
 	ProcessorInformation       []ProcessorInformation
 	CacheInformation           []CacheInformation
 	PortConnectorInformation   []PortConnectorInformation
 	SystemSlots                []SystemSlot
-	OEMStrings                 OEMStrings
+	OEMStrings                 []OEMStrings
 	SystemConfigurationOptions SystemConfigurationOptions
-	BIOSLanguageInformation    BIOSLanguageInformation
-	GroupAssociations          GroupAssociations
-	PhysicalMemoryArray        PhysicalMemoryArray
+	BIOSLanguageInformation    []BIOSLanguageInformation
+	GroupAssociations          []GroupAssociations
+	PhysicalMemoryArray        []PhysicalMemoryArray
 	MemoryDevices              []MemoryDevice
+	OnboardDevices             []OnboardDevice
 }
 
 // New initializes and returns a new `SMBIOS`.
 func New() (*SMBIOS, error) {
 	rc, ep, err := smbios.Stream()
 	if err != nil {
+		// Fall back to the structures exposed one by one, if any.
+		if s, entriesErr := DecodeDMIEntries(DefaultDMIEntriesPath); entriesErr == nil {
+			return s, nil
+		}
+
 		return nil, fmt.Errorf("failed to open stream: %w", err)
 	}
 
@@ -55,31 +66,140 @@ func New() (*SMBIOS, error) {
 	var version Version
 	version.Major, version.Minor, version.Revision = ep.Version()
 
-	return Decode(rc, version)
+	s, err := Decode(rc, version)
+	if err != nil {
+		return nil, err
+	}
+
+	s.EntryPoint = ep
+
+	return s, nil
 }
 
 // Decode decodes the stream of the provided `Reader` and returns a new `SMBIOS`.
 func Decode(rc io.Reader, version Version) (*SMBIOS, error) {
-	s := &SMBIOS{}
+	structures, err := _DecodeStructures(rc)
+	if err != nil {
+		return nil, err
+	}
 
-	s.Version = version
+	s := &SMBIOS{
+		Version:    version,
+		Structures: structures,
+	}
 
-	d := smbios.NewDecoder(rc)
+	s._Destructure(s.Structures)
 
-	structures, err := d.Decode()
+	return s, nil
+}
+
+// DecodeDump decodes a table, optionally preceded by its entry point, and returns a new `SMBIOS`.
+// The entry point may be directly followed by the table, as when concatenating
+// /sys/firmware/dmi/tables/smbios_entry_point and /sys/firmware/dmi/tables/DMI,
+// or the table may start at the offset given by the entry point, as in dmidecode dumps.
+// The version is read from the entry point, or inferred from the structures when
+// there is none, see `InferVersion`.
+func DecodeDump(r io.Reader) (*SMBIOS, error) {
+	b, err := io.ReadAll(r)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode structures: %w", err)
+		return nil, fmt.Errorf("failed to read dump: %w", err)
+	}
+
+	if !bytes.HasPrefix(b, []byte("_SM")) && !bytes.HasPrefix(b, []byte("_DMI_")) {
+		return _DecodeInferred(bytes.NewReader(b))
+	}
+
+	ep, err := ParseEntryPoint(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse entry point: %w", err)
+	}
+
+	var table []byte
+
+	// dmidecode rewrites the table address to the offset of the table in the dump.
+	if address, _ := ep.Table(); address == dumpTableOffset && address <= len(b) {
+		if ep, table, err = ReadDump(bytes.NewReader(b)); err != nil {
+			return nil, err
+		}
+
+		return _DecodeTable(ep, table)
+	}
+
+	table = b[_GetEntryPointLength(ep):]
+
+	if _, size := ep.Table(); size > 0 && size < len(table) {
+		table = table[:size]
+	}
+
+	return _DecodeTable(ep, table)
+}
+
+// _DecodeInferred decodes a table without entry point, inferring its version.
+func _DecodeInferred(r io.Reader) (*SMBIOS, error) {
+	structures, err := _DecodeStructures(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &SMBIOS{
+		Version:         InferVersion(structures),
+		VersionInferred: true,
+		Structures:      structures,
+	}
+
+	s._Destructure(s.Structures)
+
+	return s, nil
+}
+
+// _DecodeTable decodes the given table, using the version of its entry point.
+func _DecodeTable(ep EntryPoint, table []byte) (*SMBIOS, error) {
+	var version Version
+	version.Major, version.Minor, version.Revision = ep.Version()
+
+	s, err := Decode(bytes.NewReader(table), version)
+	if err != nil {
+		return nil, err
 	}
 
-	s.Structures = structures
-	s._Destructure(structures)
+	s.EntryPoint = ep
 
 	return s, nil
 }
 
+// _GetEntryPointLength returns the length of the given entry point.
+func _GetEntryPointLength(ep EntryPoint) int {
+	switch ep := ep.(type) {
+	case *EntryPoint32Bit:
+		return int(ep.Length)
+	case *EntryPoint64Bit:
+		return int(ep.Length)
+	case *EntryPointLegacy:
+		return EntryPointLegacyLength
+	default:
+		return 0
+	}
+}
+
+// _DecodeStructures decodes all the structures of the stream of the provided `Reader`.
+func _DecodeStructures(r io.Reader) ([]*Structure, error) {
+	var structures []*Structure
+
+	err := NewDecoder(r).Walk(func(structure *Structure) bool {
+		structures = append(structures, structure)
+
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode structures: %w", err)
+	}
+
+	return structures, nil
+}
+
 // _Destructure destructures the slice of `Structure`s and
 // stores the resulting information inside this `SMBIOS`.
-func (s *SMBIOS) _Destructure(structures []*smbios.Structure) {
+func (s *SMBIOS) _Destructure(structures []*Structure) {
 	for _, structure := range structures {
 		switch structure.Header.Type {
 		case 0:
@@ -89,7 +209,8 @@ func (s *SMBIOS) _Destructure(structures []*smbios.Structure) {
 		case 2:
 			s.BaseboardInformation = *NewBaseboardInformation(structure)
 		case 3:
-			s.SystemEnclosure = *NewSystemEnclosure(structure)
+			systemEnclosure := *NewSystemEnclosure(structure)
+			s.SystemEnclosure = append(s.SystemEnclosure, systemEnclosure)
 		case 4:
 			processorInformation := *NewProcessorInformation(structure)
 			s.ProcessorInformation = append(s.ProcessorInformation, processorInformation)
@@ -109,20 +230,27 @@ func (s *SMBIOS) _Destructure(structures []*smbios.Structure) {
 		case 10:
 			// Obsolete.
 		case 11:
-			s.OEMStrings = *NewOEMStrings(structure)
+			oemStrings := *NewOEMStrings(structure)
+			s.OEMStrings = append(s.OEMStrings, oemStrings)
 		case 12:
 			s.SystemConfigurationOptions = *NewSystemConfigurationOptions(structure)
 		case 13:
-			s.BIOSLanguageInformation = *NewBIOSLanguageInformation(structure)
+			biosLanguageInformation := *NewBIOSLanguageInformation(structure)
+			s.BIOSLanguageInformation = append(s.BIOSLanguageInformation, biosLanguageInformation)
 		case 14:
-			s.GroupAssociations = *NewGroupAssociations(structure)
+			groupAssociations := *NewGroupAssociations(structure)
+			s.GroupAssociations = append(s.GroupAssociations, groupAssociations)
 		case 15:
 			// Unimplemented.
 		case 16:
-			s.PhysicalMemoryArray = *NewPhysicalMemoryArray(structure)
+			physicalMemoryArray := *NewPhysicalMemoryArray(structure)
+			s.PhysicalMemoryArray = append(s.PhysicalMemoryArray, physicalMemoryArray)
 		case 17:
 			memoryDevice := *NewMemoryDevice(structure)
 			s.MemoryDevices = append(s.MemoryDevices, memoryDevice)
+		case 41:
+			onboardDevice := *NewOnboardDevice(structure)
+			s.OnboardDevices = append(s.OnboardDevices, onboardDevice)
 		}
 	}
 }
@@ -135,7 +263,7 @@ var (
 )
 
 // GetStrings retrieves all strings in the given structure.
-func GetStrings(s *smbios.Structure) []string {
+func GetStrings(s *Structure) []string {
 	if s.Strings == nil {
 		return []string{}
 	}
@@ -145,7 +273,7 @@ func GetStrings(s *smbios.Structure) []string {
 
 // GetStringOrEmpty retrieves a string at the given offset.
 // Returns an empty string if no string was present.
-func GetStringOrEmpty(s *smbios.Structure, offset int) string {
+func GetStringOrEmpty(s *Structure, offset int) string {
 	index := GetByte(s, offset)
 
 	if index == 0 || int(index) > len(s.Strings) {
@@ -171,54 +299,52 @@ func GetStringOrEmpty(s *smbios.Structure, offset int) string {
 }
 
 // GetByte retrieves a 8-bit unsigned integer at the given offset.
-func GetByte(s *smbios.Structure, offset int) uint8 {
-	// the `Formatted` byte slice is missing the first 4 bytes of the structure that are stripped out as header info.
-	// so we need to subtract 4 from the offset mentioned in the SMBIOS documentation to get the right value.
-	index := offset - 4
-	if index >= len(s.Formatted) {
-		return 0
-	}
+// Returns 0 if the offset is out of range, see `Structure.Byte`.
+func GetByte(s *Structure, offset int) uint8 {
+	v, _ := s.Byte(offset) //nolint:errcheck
 
-	return s.Formatted[index]
+	return v
 }
 
 // GetWord retrieves a 16-bit unsigned integer at the given offset.
-func GetWord(s *smbios.Structure, offset int) uint16 {
-	// the `Formatted` byte slice is missing the first 4 bytes of the structure that are stripped out as header info.
-	// so we need to subtract 4 from the offset mentioned in the SMBIOS documentation to get the right value.
-	index := offset - 4
-	if index+2 > len(s.Formatted) {
-		return 0
-	}
+// Returns 0 if the offset is out of range, see `Structure.Word`.
+func GetWord(s *Structure, offset int) uint16 {
+	v, _ := s.Word(offset) //nolint:errcheck
 
-	return binary.LittleEndian.Uint16(s.Formatted[index : index+2])
+	return v
 }
 
 // GetDWord retrieves a 32-bit unsigned integer at the given offset.
-func GetDWord(s *smbios.Structure, offset int) uint32 {
-	// the `Formatted` byte slice is missing the first 4 bytes of the structure that are stripped out as header info.
-	// so we need to subtract 4 from the offset mentioned in the SMBIOS documentation to get the right value.
-	index := offset - 4
-	if index+4 > len(s.Formatted) {
-		return 0
-	}
+// Returns 0 if the offset is out of range, see `Structure.DWord`.
+func GetDWord(s *Structure, offset int) uint32 {
+	v, _ := s.DWord(offset) //nolint:errcheck
 
-	return binary.LittleEndian.Uint32(s.Formatted[index : index+4])
+	return v
 }
 
 // GetQWord retrieves a 64-bit unsigned integer at the given offset.
-func GetQWord(s *smbios.Structure, offset int) uint64 {
-	// the `Formatted` byte slice is missing the first 4 bytes of the structure that are stripped out as header info.
-	// so we need to subtract 4 from the offset mentioned in the SMBIOS documentation to get the right value.
-	index := offset - 4
-	if index+8 > len(s.Formatted) {
-		return 0
-	}
+// Returns 0 if the offset is out of range, see `Structure.QWord`.
+func GetQWord(s *Structure, offset int) uint64 {
+	v, _ := s.QWord(offset) //nolint:errcheck
 
-	return binary.LittleEndian.Uint64(s.Formatted[index : index+8])
+	return v
 }
 
 // IsNthBitSet returns true if the `n`th bit is 1 inside `b`.
 func IsNthBitSet(b, n int) bool {
 	return b&(1<<n) != 0
 }
+
+// _GetBitNames returns the names of the bits that are set inside `b`,
+// where `names[n]` is the name of the `n`th bit. Bits without a name are skipped.
+func _GetBitNames(b int, names []string) []string {
+	var set []string
+
+	for n, name := range names {
+		if name != "" && IsNthBitSet(b, n) {
+			set = append(set, name)
+		}
+	}
+
+	return set
+}
@@ -5,8 +5,15 @@
 package smbios_test
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/json"
+	"fmt"
+	"io"
+	"math"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -41,8 +48,7 @@ func DoTestDesktopManagementInterface(t *testing.T, name string) {
 		//nolint: errcheck
 		defer stream.Close()
 
-		version := smbios.Version{Major: 3, Minor: 3, Revision: 0} // dummy version
-		actual, err := smbios.Decode(stream, version)
+		actual, err := smbios.DecodeDump(stream)
 		require.NoError(t, err)
 
 		expectedJSON, err := os.ReadFile("testdata/" + name + ".json")
@@ -52,9 +58,1311 @@ func DoTestDesktopManagementInterface(t *testing.T, name string) {
 
 		require.NoError(t, json.Unmarshal(expectedJSON, &expected))
 
-		// remove parsed structures as they are not in JSON
+		// remove parsed structures and entry point as they are not in JSON
 		actual.Structures = nil
+		actual.EntryPoint = nil
 
 		require.Equal(t, &expected, actual)
 	})
 }
+
+func decodeTestdata(t *testing.T, name string) *smbios.SMBIOS {
+	t.Helper()
+
+	stream, err := os.Open("testdata/" + name + ".dmi")
+	require.NoError(t, err)
+
+	//nolint: errcheck
+	defer stream.Close()
+
+	s, err := smbios.DecodeDump(stream)
+	require.NoError(t, err)
+
+	return s
+}
+
+func TestSystemEnclosure(t *testing.T) {
+	t.Parallel()
+
+	var table []byte
+
+	table = append(table, encodeStructure(3, 0x0300, []byte{
+		1, 0x80 | byte(smbios.ChassisTypeRackMountChassis), 2, 3, 4,
+		3, 3, 3, 3,
+		0x78, 0x56, 0x34, 0x12,
+		2, 2,
+		2, 3, // contained element count and record length
+		0x84, 1, 2, // two processors
+		byte(smbios.BoardTypeMotherboard), 1, 1,
+		5,
+	}, "ACME", "1.0", "SN123", "AT456", "SKU-2U")...)
+	table = append(table, encodeStructure(127, 0xFFFF, nil)...)
+
+	s, err := smbios.Decode(bytes.NewReader(table), smbios.Version{Major: 3, Minor: 3})
+	require.NoError(t, err)
+	require.Len(t, s.SystemEnclosure, 1)
+
+	enclosure := s.SystemEnclosure[0]
+	require.Equal(t, "ACME", enclosure.Manufacturer)
+	require.Equal(t, smbios.ChassisTypeRackMountChassis, enclosure.ChassisType)
+	require.True(t, enclosure.ChassisLock)
+	require.Equal(t, smbios.ChassisStateSafe, enclosure.ThermalState)
+	require.Equal(t, smbios.ChassisSecurityStatusNone, enclosure.SecurityStatus)
+	require.Equal(t, uint32(0x12345678), enclosure.OEMDefined)
+	require.Equal(t, uint8(2), enclosure.Height)
+	require.Equal(t, uint8(2), enclosure.NumberOfPowerCords)
+	require.Equal(t, []smbios.ContainedElement{
+		{Type: 0x84, Minimum: 1, Maximum: 2},
+		{Type: smbios.ContainedElementType(smbios.BoardTypeMotherboard), Minimum: 1, Maximum: 1},
+	}, enclosure.ContainedElements)
+	require.True(t, enclosure.ContainedElements[0].Type.IsStructureType())
+	require.Equal(t, uint8(4), enclosure.ContainedElements[0].Type.StructureType())
+	require.False(t, enclosure.ContainedElements[1].Type.IsStructureType())
+	require.Equal(t, smbios.BoardTypeMotherboard, enclosure.ContainedElements[1].Type.BoardType())
+	require.Equal(t, "SKU-2U", enclosure.SKUNumber)
+	require.Empty(t, enclosure.AbsentFields)
+}
+
+func TestProcessorInformation(t *testing.T) {
+	t.Parallel()
+
+	formatted := make([]byte, 0x34-4)
+	formatted[0x04-4] = 1
+	formatted[0x05-4] = byte(smbios.ProcessorTypeCentralProcessor)
+	formatted[0x06-4] = byte(smbios.ProcessorFamilyIndicatorFamily2)
+	formatted[0x07-4] = 2
+	formatted[0x11-4] = 0x80 | 18
+	formatted[0x18-4] = 0x41
+	formatted[0x19-4] = 0x11
+	formatted[0x23-4] = 0xFF
+	formatted[0x24-4] = 0xFF
+	formatted[0x25-4] = 0xFF
+	binary.LittleEndian.PutUint16(formatted[0x26-4:], 0x00DC)
+	binary.LittleEndian.PutUint16(formatted[0x28-4:], 0x0101)
+	binary.LittleEndian.PutUint16(formatted[0x2A-4:], 128)
+	binary.LittleEndian.PutUint16(formatted[0x2C-4:], 96)
+	binary.LittleEndian.PutUint16(formatted[0x2E-4:], 256)
+	binary.LittleEndian.PutUint16(formatted[0x30-4:], 192)
+	formatted[0x32-4] = 3
+
+	var table []byte
+
+	table = append(table, encodeStructure(4, 0x0400, formatted, "CPU0", "Ampere(R)", "LGA4926")...)
+	table = append(table, encodeStructure(127, 0xFFFF, nil)...)
+
+	s, err := smbios.Decode(bytes.NewReader(table), smbios.Version{Major: 3, Minor: 6})
+	require.NoError(t, err)
+	require.Len(t, s.ProcessorInformation, 1)
+	require.Equal(t, s.Version, smbios.InferVersion(s.Structures))
+
+	p := s.ProcessorInformation[0]
+	require.Equal(t, "CPU0", p.SocketDesignation)
+	require.Equal(t, smbios.ProcessorFamily(0x0101), p.Family())
+	require.Equal(t, "ARMv8", p.FamilyName())
+	require.Equal(t, 128, p.Cores())
+	require.Equal(t, 96, p.EnabledCores())
+	require.Equal(t, 256, p.Threads())
+	require.Equal(t, uint16(192), p.ThreadEnabled)
+	require.Equal(t, []float32{1.8}, p.Voltage.Voltages())
+	require.Equal(t, "1.8 V", p.Voltage.String())
+	require.True(t, p.Status.SocketPopulated())
+	require.Equal(t, smbios.ProcessorCPUStatusEnabled, p.Status.CPUStatus())
+	require.Equal(t, "Socket 940", p.ProcessorUpgrade.String())
+	require.True(t, p.ProcessorCharacteristics.Is64BitCapable())
+	require.True(t, p.ProcessorCharacteristics.MultiCore())
+	require.True(t, p.ProcessorCharacteristics.HardwareThread())
+	require.False(t, p.ProcessorCharacteristics.ExecuteProtection())
+	require.Equal(t, "LGA4926", p.SocketType)
+	require.Empty(t, p.AbsentFields)
+
+	for _, test := range []struct {
+		manufacturer string
+		expected     string
+	}{
+		{"Intel(R) Corporation", "Core 2"},
+		{"AMD", "K7"},
+		{"", smbios.ProcessorFamilyCore2OrK7.String()},
+	} {
+		p := smbios.ProcessorInformation{ProcessorFamily: smbios.ProcessorFamilyCore2OrK7, ProcessorManufacturer: test.manufacturer}
+		require.Equal(t, test.expected, p.FamilyName())
+	}
+
+	// the *2 fields are only used when the 8-bit field holds the 0xFF escape
+	require.Equal(t, 16, smbios.ProcessorInformation{CoreCount: 16, CoreCount2: 128}.Cores())
+	require.Equal(t, 255, smbios.ProcessorInformation{ThreadCount: 0xFF, ThreadCount2: 0xFFFF}.Threads())
+	require.Equal(t, 255, smbios.ProcessorInformation{CoreCount: 0xFF}.Cores())
+
+	require.Equal(t, []float32{5.0, 3.3}, smbios.ProcessorVoltage(0x03).Voltages())
+	require.Equal(t, "5.0 V 3.3 V", smbios.ProcessorVoltage(0x03).String())
+	require.Nil(t, smbios.ProcessorVoltage(0x80).Voltages())
+	require.Equal(t, "Unknown", smbios.ProcessorVoltage(0x80).String())
+
+	require.False(t, smbios.ProcessorStatus(0x04).SocketPopulated())
+	require.Equal(t, "Disabled By BIOS", smbios.ProcessorStatus(0x43).CPUStatus().String())
+	require.Equal(t, "Reserved", smbios.ProcessorCPUStatus(5).String())
+	require.Equal(t, "Unknown", smbios.ProcessorUpgrade(0xFFFF).String())
+}
+
+func TestProcessorID(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name      string
+		processor smbios.ProcessorInformation
+		x86       bool
+		signature string
+		features  []string
+		uarch     string
+	}{
+		{
+			name: "Opteron",
+			processor: smbios.ProcessorInformation{
+				ProcessorFamily:       0x8B,
+				ProcessorManufacturer: "AMD",
+				ProcessorID:           0x178BFBFF_00100F91,
+			},
+			x86:       true,
+			signature: "Type 0, Family 16, Model 9, Stepping 1",
+			features: []string{
+				"FPU", "VME", "DE", "PSE", "TSC", "MSR", "PAE", "MCE", "CX8", "APIC", "SEP", "MTRR", "PGE", "MCA",
+				"CMOV", "PAT", "PSE-36", "CLFSH", "MMX", "FXSR", "SSE", "SSE2", "HTT",
+			},
+			uarch: "K10",
+		},
+		{
+			name: "Broadwell",
+			processor: smbios.ProcessorInformation{
+				ProcessorFamily:       0xB3,
+				ProcessorManufacturer: "Intel",
+				ProcessorID:           0x00000201_000406F1,
+			},
+			x86:       true,
+			signature: "Type 0, Family 6, Model 79, Stepping 1",
+			features:  []string{"FPU", "APIC"},
+			uarch:     "Broadwell",
+		},
+		{
+			name: "Cascade Lake",
+			processor: smbios.ProcessorInformation{
+				ProcessorFamily:       0xB3,
+				ProcessorManufacturer: "Intel(R) Corporation",
+				ProcessorID:           0x00050657,
+			},
+			x86:       true,
+			signature: "Type 0, Family 6, Model 85, Stepping 7",
+			uarch:     "Cascade Lake",
+		},
+		{
+			name: "Hygon",
+			processor: smbios.ProcessorInformation{
+				ProcessorFamily:       0x01,
+				ProcessorManufacturer: "Chengdu Hygon",
+				ProcessorID:           0x00900F01,
+			},
+			x86:       true,
+			signature: "Type 0, Family 24, Model 0, Stepping 1",
+		},
+		{
+			name: "Zhaoxin",
+			processor: smbios.ProcessorInformation{
+				ProcessorFamily:       0x02,
+				ProcessorManufacturer: "Shanghai Zhaoxin",
+				ProcessorID:           0x000107B5,
+			},
+			x86:       true,
+			signature: "Type 0, Family 7, Model 11, Stepping 5",
+		},
+		{
+			name: "VIA",
+			processor: smbios.ProcessorInformation{
+				ProcessorFamily:       0xD9,
+				ProcessorManufacturer: "CentaurHauls",
+				ProcessorID:           0x000006FD,
+			},
+			x86:       true,
+			signature: "Type 0, Family 6, Model 15, Stepping 13",
+		},
+		{
+			name: "no manufacturer",
+			processor: smbios.ProcessorInformation{
+				ProcessorFamily: 0xB3,
+				ProcessorID:     0x000406F1,
+			},
+			x86:       true,
+			signature: "Type 0, Family 6, Model 79, Stepping 1",
+		},
+		{
+			name: "ARMv8",
+			processor: smbios.ProcessorInformation{
+				ProcessorFamily:       smbios.ProcessorFamilyIndicatorFamily2,
+				ProcessorFamily2:      0x101,
+				ProcessorManufacturer: "Intel",
+				ProcessorID:           0x000406F1,
+			},
+		},
+		{
+			name: "Arm SoC ID",
+			processor: smbios.ProcessorInformation{
+				ProcessorFamily:          0x01,
+				ProcessorManufacturer:    "AMD",
+				ProcessorCharacteristics: 0x0200,
+				ProcessorID:              0x00000001_0A160001,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.x86, test.processor.IsX86())
+			require.Equal(t, test.uarch, test.processor.Microarchitecture())
+
+			if test.x86 {
+				require.Equal(t, test.signature, test.processor.ProcessorID.Signature().String())
+				require.Equal(t, test.features, test.processor.ProcessorID.Features().Flags())
+			}
+		})
+	}
+
+	signature := smbios.ProcessorID(0x00100F91).Signature()
+	require.Equal(t, smbios.CPUIDSignature{Stepping: 1, Model: 9, Family: 0x0F, ExtendedFamily: 1}, signature)
+	require.Equal(t, 0x10, signature.DisplayFamily())
+	require.Equal(t, 9, signature.DisplayModel())
+
+	features := smbios.ProcessorID(0x178BFBFF_00100F91).Features()
+	require.True(t, features.Has(25))
+	require.False(t, features.Has(10))
+	require.Equal(t, "91 0F 10 00 FF FB 8B 17", smbios.ProcessorID(0x178BFBFF_00100F91).String())
+
+	socID := smbios.ProcessorID(0x00000001_0A160001).SoCID()
+	require.Equal(t, smbios.ArmSoCID{JEP106Bank: 0x0A, JEP106Code: 0x16, SoCID: 0x0001, Revision: 1}, socID)
+	require.Equal(t, smbios.JEP106ID{Bank: 11, Code: 0x16}, socID.JEP106())
+	require.Equal(t, "JEP106 10:0x16, SoC ID 0x0001, Revision 0x00000001", socID.String())
+}
+
+func TestProcessorCaches(t *testing.T) {
+	t.Parallel()
+
+	s := decodeTestdata(t, "Dell-PowerEdge-R630-Dual-Xeon")
+	require.Len(t, s.ProcessorInformation, 2)
+
+	caches := s.ProcessorCaches(s.ProcessorInformation[0])
+	require.NotNil(t, caches.L1)
+	require.NotNil(t, caches.L2)
+	require.NotNil(t, caches.L3)
+	require.Equal(t, smbios.CacheHandle(0x0702), caches.L3.Cache.Handle)
+	require.False(t, caches.L3.Shared())
+	require.Equal(t, 3, caches.L3.Cache.CacheConfiguration.Level())
+	require.Equal(t, uint64(20*1024*1024), caches.L3.Cache.InstalledSizeBytes())
+
+	// the second socket is not populated and has no caches
+	require.Equal(t, smbios.ProcessorCaches{}, s.ProcessorCaches(s.ProcessorInformation[1]))
+
+	s = decodeTestdata(t, "SuperMicro-Quad-Opteron")
+	require.Len(t, s.ProcessorInformation, 4)
+
+	for _, p := range s.ProcessorInformation {
+		caches := s.ProcessorCaches(p)
+		require.NotNil(t, caches.L3)
+		require.Equal(t, p.L3CacheHandle, caches.L3.Cache.Handle)
+	}
+}
+
+func TestPortConnectorInformation(t *testing.T) {
+	t.Parallel()
+
+	var table []byte
+
+	table = append(table, encodeStructure(8, 0x0800, []byte{0, 0, 1, 0x23, 0x10}, "USB-C 1")...)
+	table = append(table, encodeStructure(8, 0x0801, []byte{0, 0, 1, 0x23, 0x10}, "USB-C 2")...)
+	table = append(table, encodeStructure(8, 0x0802, []byte{1, 0x12, 0, 0, 0x10}, "USB3_4")...)
+	table = append(table, encodeStructure(8, 0x0803, []byte{1, 0xFF, 2, 0xA0, 0xA1}, "COM_HDR", "COM1")...)
+	table = append(table, encodeStructure(127, 0xFFFF, nil)...)
+
+	s, err := smbios.Decode(bytes.NewReader(table), smbios.Version{Major: 3, Minor: 3})
+	require.NoError(t, err)
+	require.Len(t, s.PortConnectorInformation, 4)
+
+	usbC := 0
+
+	for _, port := range s.PortConnectorInformation {
+		if port.ExternalConnectorType == smbios.PortConnectorTypeUSBTypeCReceptacle {
+			usbC++
+		}
+	}
+
+	require.Equal(t, 2, usbC)
+
+	port := s.PortConnectorInformation[0]
+	require.Empty(t, port.InternalReferenceDesignator)
+	require.Equal(t, smbios.PortConnectorTypeNone, port.InternalConnectorType)
+	require.Equal(t, "USB-C 1", port.ExternalReferenceDesignator)
+	require.Equal(t, "USB Type-C Receptacle", port.ExternalConnectorType.String())
+	require.Equal(t, smbios.PortTypeUSB, port.PortType)
+	require.Equal(t, "USB", port.PortType.String())
+
+	port = s.PortConnectorInformation[2]
+	require.Equal(t, "USB3_4", port.InternalReferenceDesignator)
+	require.Equal(t, "Access Bus (USB)", port.InternalConnectorType.String())
+
+	port = s.PortConnectorInformation[3]
+	require.Equal(t, "COM_HDR", port.InternalReferenceDesignator)
+	require.Equal(t, "COM1", port.ExternalReferenceDesignator)
+	require.Equal(t, smbios.PortConnectorTypeOther, port.InternalConnectorType)
+	require.Equal(t, "Other", port.InternalConnectorType.String())
+	require.Equal(t, smbios.PortConnectorTypePC98, port.ExternalConnectorType)
+	require.Equal(t, "PC-98", port.ExternalConnectorType.String())
+	require.Equal(t, smbios.PortType8251FIFOCompatible, port.PortType)
+	require.Equal(t, "8251 FIFO Compatible", port.PortType.String())
+
+	require.Equal(t, "Unknown", smbios.PortConnectorType(0x24).String())
+	require.Equal(t, "Unknown", smbios.PortType(0x9F).String())
+}
+
+func TestFreePCIeSlots(t *testing.T) {
+	t.Parallel()
+
+	s := decodeTestdata(t, "SuperMicro-Dual-Xeon")
+
+	slots := s.FreePCIeSlots()
+	require.Len(t, slots, 1)
+	require.Equal(t, "RSC-R1UW-2E16 SLOT2 PCI-E x16", slots[0].SlotDesignation)
+	require.Equal(t, smbios.SlotTypePCIExpressGen2X16, slots[0].SlotType)
+	require.Equal(t, 16, slots[0].Width().Lanes())
+}
+
+func TestSystemSlotPCIAddress(t *testing.T) {
+	t.Parallel()
+
+	var table []byte
+
+	// SMBIOS 2.1 slot, without segment, bus and device/function numbers
+	table = append(table, encodeStructure(9, 0x0900, []byte{1, 0xA5, 0x0D, 3, 4, 1, 0, 0x04, 0x01}, "PCIE1")...)
+	table = append(table, encodeStructure(9, 0x0901, []byte{1, 0xA5, 0x0D, 4, 4, 2, 0, 0x04, 0x01, 0, 0, 0x3B, 0x10}, "PCIE2")...)
+	table = append(table, encodeStructure(127, 0xFFFF, nil)...)
+
+	s, err := smbios.Decode(bytes.NewReader(table), smbios.Version{Major: 2, Minor: 6})
+	require.NoError(t, err)
+	require.Len(t, s.SystemSlots, 2)
+
+	require.False(t, s.SystemSlots[0].HasPCIAddress())
+	require.Equal(t, []string{"SegmentGroupNumber", "BusNumber", "DeviceFunctionNumber"}, s.SystemSlots[0].AbsentFields[:3])
+
+	require.True(t, s.SystemSlots[1].HasPCIAddress())
+	require.Equal(t, "0000:3b:02.0", s.SystemSlots[1].PCIAddress().String())
+}
+
+func TestPCIDevices(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	devices := filepath.Join(root, "bus", "pci", "devices")
+
+	require.NoError(t, os.MkdirAll(devices, 0o755))
+
+	for _, dev := range []struct {
+		path  string
+		id    string
+		class string
+		block []string
+		net   []string
+	}{
+		{path: "0000:00:00.0", id: "0x2f00", class: "0x060000"},
+		{path: "0000:00:01.0", id: "0x2f02", class: "0x060400"},
+		{path: "0000:00:01.0/0000:01:00.0", id: "0x165f", class: "0x020000", net: []string{"net/eno1"}},
+		{path: "0000:00:01.0/0000:01:00.1", id: "0x165f", class: "0x020000", net: []string{"net/eno2"}},
+		{path: "0000:00:02.0", id: "0x2f04", class: "0x060400"},
+		{path: "0000:00:02.0/0000:04:00.0", id: "0x1572", class: "0x020000", net: []string{"net/eth3"}},
+		{path: "0000:00:02.0/0000:04:00.1", id: "0x1572", class: "0x020000", net: []string{"net/eth4"}},
+		{path: "0000:00:03.0", id: "0x2f08", class: "0x060400"},
+		{
+			path:  "0000:00:03.0/0000:03:00.0",
+			id:    "0x005d",
+			class: "0x010400",
+			block: []string{"host0/target0:2:0/0:2:0:0/block/sda", "host0/target0:2:1/0:2:1:0/block/sdb"},
+		},
+		// root ports of a single device feeding different slots
+		{path: "0000:00:1c.0", id: "0xa110", class: "0x060400"},
+		{path: "0000:00:1c.0/0000:05:00.0", id: "0x1533", class: "0x020000", net: []string{"net/eth5"}},
+		{path: "0000:00:1c.4", id: "0xa114", class: "0x060400"},
+		{path: "0000:00:1c.4/0000:06:00.0", id: "0xf1a8", class: "0x010802", block: []string{"nvme/nvme0/nvme0n1"}},
+	} {
+		path := filepath.Join(root, "devices", "pci0000:00", dev.path)
+
+		require.NoError(t, os.MkdirAll(path, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "vendor"), []byte("0x8086\n"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "device"), []byte(dev.id+"\n"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "class"), []byte(dev.class+"\n"), 0o644))
+
+		for subsystem, names := range map[string][]string{"net": dev.net, "block": dev.block} {
+			for _, name := range names {
+				require.NoError(t, os.MkdirAll(filepath.Join(path, name), 0o755))
+				require.NoError(t, os.Symlink("../../class/"+subsystem, filepath.Join(path, name, "subsystem")))
+			}
+		}
+
+		require.NoError(t, os.Symlink(path, filepath.Join(devices, filepath.Base(dev.path))))
+	}
+
+	// partitions are not reported
+	partition := filepath.Join(root, "devices", "pci0000:00", "0000:00:03.0", "0000:03:00.0", "host0", "target0:2:0", "0:2:0:0", "block", "sda", "sda1")
+	require.NoError(t, os.MkdirAll(partition, 0o755))
+	require.NoError(t, os.Symlink("../../class/block", filepath.Join(partition, "subsystem")))
+	require.NoError(t, os.WriteFile(filepath.Join(partition, "partition"), []byte("1\n"), 0o644))
+
+	s := decodeTestdata(t, "Dell-PowerEdge-R630-Dual-Xeon")
+
+	slots, err := s.SlotPCIDevices(devices)
+	require.NoError(t, err)
+	require.Len(t, slots, 1)
+	require.Equal(t, "PCIe Slot 2", slots[0].Slot.SlotDesignation)
+	require.Len(t, slots[0].Devices, 2)
+	require.Equal(t, "0000:04:00.0", slots[0].Devices[0].Address.String())
+	require.Equal(t, uint16(0x8086), slots[0].Devices[0].VendorID)
+	require.Equal(t, uint16(0x1572), slots[0].Devices[0].DeviceID)
+	require.Equal(t, uint32(0x020000), slots[0].Devices[0].Class)
+	require.Equal(t, []string{"eth3"}, slots[0].Devices[0].NetDevices)
+	require.Equal(t, []string{"eth4"}, slots[0].Devices[1].NetDevices)
+
+	onboard, err := s.OnboardPCIDevices(devices)
+	require.NoError(t, err)
+	require.Len(t, onboard, len(s.OnboardDevices))
+	require.Equal(t, "Integrated NIC 1", onboard[0].Device.ReferenceDesignation)
+	require.Len(t, onboard[0].Devices, 1)
+	require.Equal(t, []string{"eno1"}, onboard[0].Devices[0].NetDevices)
+	require.Equal(t, "Integrated RAID", onboard[4].Device.ReferenceDesignation)
+	require.Len(t, onboard[4].Devices, 1)
+	require.Equal(t, []string{"sda", "sdb"}, onboard[4].Devices[0].BlockDevices)
+	require.Empty(t, onboard[5].Devices)
+
+	// slots without address fields must not match the host bridge at 0000:00:00.0
+	var table []byte
+
+	table = append(table, encodeStructure(9, 0x0900, []byte{1, 0xA5, 0x0D, 3, 4, 1, 0, 0x04, 0x01}, "PCIE1")...)
+	table = append(table, encodeStructure(9, 0x0901, []byte{1, 0xA5, 0x0D, 4, 4, 2, 0, 0x04, 0x01, 0, 0, 0, 0x10}, "PCIE2")...)
+	table = append(table, encodeStructure(9, 0x0902, []byte{1, 0xA5, 0x0D, 4, 4, 3, 0, 0x04, 0x01, 0, 0, 0, 0xE0}, "PCIE3")...)
+	table = append(table, encodeStructure(9, 0x0903, []byte{1, 0xA5, 0x0D, 4, 4, 4, 0, 0x04, 0x01, 0, 0, 0, 0xE4}, "PCIE4")...)
+	table = append(table, encodeStructure(127, 0xFFFF, nil)...)
+
+	s, err = smbios.Decode(bytes.NewReader(table), smbios.Version{Major: 2, Minor: 6})
+	require.NoError(t, err)
+
+	slots, err = s.SlotPCIDevices(devices)
+	require.NoError(t, err)
+	require.Len(t, slots, 3)
+	require.Equal(t, "PCIE2", slots[0].Slot.SlotDesignation)
+	require.Len(t, slots[0].Devices, 3)
+	require.Equal(t, "0000:00:02.0", slots[0].Devices[0].Address.String())
+	require.True(t, slots[0].Devices[0].IsBridge())
+	require.Equal(t, []string{"eth3"}, slots[0].Devices[1].NetDevices)
+
+	// a root port only matches its own function, not its sibling ports
+	require.Equal(t, "PCIE3", slots[1].Slot.SlotDesignation)
+	require.Len(t, slots[1].Devices, 2)
+	require.Equal(t, "0000:00:1c.0", slots[1].Devices[0].Address.String())
+	require.Equal(t, []string{"eth5"}, slots[1].Devices[1].NetDevices)
+	require.Equal(t, "PCIE4", slots[2].Slot.SlotDesignation)
+	require.Len(t, slots[2].Devices, 2)
+	require.Equal(t, "0000:00:1c.4", slots[2].Devices[0].Address.String())
+	require.Equal(t, []string{"nvme0n1"}, slots[2].Devices[1].BlockDevices)
+
+	_, err = s.SlotPCIDevices(filepath.Join(root, "missing"))
+	require.Error(t, err)
+}
+
+// encodeStructure encodes a raw SMBIOS structure with the given formatted area (excluding the header) and strings.
+func encodeStructure(structureType uint8, handle uint16, formatted []byte, strings ...string) []byte {
+	b := []byte{structureType, uint8(4 + len(formatted))}
+	b = binary.LittleEndian.AppendUint16(b, handle)
+	b = append(b, formatted...)
+
+	for _, s := range strings {
+		b = append(b, s...)
+		b = append(b, 0)
+	}
+
+	if len(strings) == 0 {
+		b = append(b, 0)
+	}
+
+	return append(b, 0)
+}
+
+func TestGroupMembers(t *testing.T) {
+	t.Parallel()
+
+	var table []byte
+
+	table = append(table, encodeStructure(4, 0x0400, []byte{1}, "CPU1")...)
+	table = append(table, encodeStructure(4, 0x0401, []byte{1}, "CPU2")...)
+	table = append(table, encodeStructure(17, 0x1100, nil)...)
+	table = append(table, encodeStructure(17, 0x1101, nil)...)
+	table = append(table, encodeStructure(14, 0x0E00, []byte{
+		1,
+		4, 0x01, 0x04,
+		17, 0x01, 0x11,
+		17, 0x34, 0x12, // dangling
+		4, 0x00, 0x11, // type mismatch
+	}, "Node 1")...)
+	table = append(table, encodeStructure(14, 0x0E01, []byte{1}, "Empty")...)
+	table = append(table, encodeStructure(127, 0xFFFF, nil)...)
+
+	s, err := smbios.Decode(bytes.NewReader(table), smbios.Version{Major: 3, Minor: 3})
+	require.NoError(t, err)
+	require.Len(t, s.GroupAssociations, 2)
+	require.Equal(t, "Node 1", s.GroupAssociations[0].GroupName)
+	require.Equal(t, []smbios.GroupAssociationItem{
+		{ItemType: 4, ItemHandle: 0x0401},
+		{ItemType: 17, ItemHandle: 0x1101},
+		{ItemType: 17, ItemHandle: 0x1234},
+		{ItemType: 4, ItemHandle: 0x1100},
+	}, s.GroupAssociations[0].Items)
+	require.Empty(t, s.GroupAssociations[1].Items)
+
+	members := s.GroupMembers(s.GroupAssociations[0])
+	require.Len(t, members, 4)
+	require.Same(t, &s.ProcessorInformation[1], members[0].Value)
+	require.Same(t, &s.MemoryDevices[1], members[1].Value)
+	require.Nil(t, members[2].Value)
+	require.Nil(t, members[3].Value)
+}
+
+func TestPhysicalMemoryArrays(t *testing.T) {
+	t.Parallel()
+
+	s := decodeTestdata(t, "SuperMicro-Dual-Xeon")
+	require.Len(t, s.PhysicalMemoryArray, 2)
+
+	for _, array := range s.PhysicalMemoryArray {
+		devices := s.PhysicalMemoryArrayDevices(array)
+		require.Len(t, devices, 8)
+
+		for _, device := range devices {
+			require.Equal(t, array.Handle, device.PhysicalMemoryArrayHandle)
+		}
+
+		populated, total := s.PhysicalMemoryArraySlots(array)
+		require.Equal(t, 8, populated)
+		require.Equal(t, 8, total)
+	}
+
+	require.Same(t, &s.PhysicalMemoryArray[1], s.PhysicalMemoryArrayByHandle(s.PhysicalMemoryArray[1].Handle))
+	require.Nil(t, s.PhysicalMemoryArrayByHandle(0xFFFF))
+
+	s = decodeTestdata(t, "Dell-PowerEdge-R630-Dual-Xeon")
+	require.Len(t, s.PhysicalMemoryArray, 1)
+
+	populated, total := s.PhysicalMemoryArraySlots(s.PhysicalMemoryArray[0])
+	require.Equal(t, 2, populated)
+	require.Equal(t, 24, total)
+}
+
+func TestMemoryDevices(t *testing.T) {
+	t.Parallel()
+
+	s := decodeTestdata(t, "ASRock-Single-Ryzen")
+
+	var device *smbios.MemoryDevice
+
+	for i := range s.MemoryDevices {
+		if s.MemoryDevices[i].Size != 0 {
+			device = &s.MemoryDevices[i]
+
+			break
+		}
+	}
+
+	require.NotNil(t, device)
+	require.Equal(t, 2, device.Attributes.Rank())
+	require.Equal(t, smbios.MemoryTechnologyDRAM, device.MemoryTechnology)
+	require.True(t, device.MemoryOperatingModeCapability.Volatile())
+	require.Equal(t, []string{"Volatile memory"}, device.MemoryOperatingModeCapability.Modes())
+	require.Equal(t, "Bank 2, Hex 0x98", device.ModuleManufacturerID.String())
+	require.Equal(t, smbios.MemoryDeviceRegionSize(32<<30), device.VolatileSize)
+	require.Equal(t, uint32(2400), device.MaximumSpeed())
+	require.Equal(t, 32*smbios.Gigabyte, device.Capacity())
+	require.Equal(t, "32 GB", device.Capacity().String())
+	require.Equal(t, 128*smbios.Gigabyte, s.PhysicalMemoryArray[0].Capacity())
+
+	s = decodeTestdata(t, "Dell-PowerEdge-R630-Dual-Xeon")
+	require.Equal(t, "3 TB", s.PhysicalMemoryArray[0].Capacity().String())
+}
+
+func TestCapacity(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		capacity smbios.Capacity
+		expected string
+	}{
+		{smbios.MemoryDeviceSize(0x0100).Capacity(), "256 MB"},
+		{smbios.MemoryDeviceSize(0x8100).Capacity(), "256 KB"},
+		{smbios.MemoryDeviceSize(0x0600).Capacity(), "1536 MB"},
+		{smbios.MemoryDeviceSize(0x7FFF).Capacity(), "0 bytes"},
+		{smbios.MemoryDeviceExtendedSize(0x00020000).Capacity(), "128 GB"},
+		{smbios.MemoryDeviceExtendedSize(0x80100000).Capacity(), "1 TB"},
+		{smbios.MaximumCapacity(512 * 1024).Capacity(), "512 MB"},
+		{smbios.ExtendedMaximumCapacity(6 << 40).Capacity(), "6 TB"},
+	} {
+		require.Equal(t, test.expected, test.capacity.String())
+	}
+
+	require.Equal(t, 0, smbios.MemoryDeviceSize(0x8100).Megabytes())
+	require.Equal(t, 256, smbios.MemoryDeviceSize(0x0100).Megabytes())
+	require.Equal(t, "512 MB", smbios.MaximumCapacity(512*1024).String())
+	require.Equal(t, uint64(1<<40), (smbios.MemoryDevice{Size: 0x7FFF, ExtendedSize: 0x00100000}).Capacity().Bytes())
+}
+
+func TestJEP106(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "Samsung", smbios.JEP106Manufacturer(1, 0xCE))
+	require.Equal(t, "Samsung", smbios.JEP106Manufacturer(1, 0x4E))
+	require.Equal(t, "Kingston", smbios.JEP106Manufacturer(2, 0x98))
+	require.Equal(t, "ARM Ltd", smbios.ArmSoCID{JEP106Bank: 4, JEP106Code: 0x3B}.Manufacturer())
+	require.Equal(t, "NVIDIA", smbios.ArmSoCID{JEP106Bank: 3, JEP106Code: 0x6B}.Manufacturer())
+	require.Equal(t, "Ampere Computing", smbios.ArmSoCID{JEP106Bank: 10, JEP106Code: 0x16}.Manufacturer())
+	require.Equal(t, "Andes Technology Corporation", smbios.JEP106Manufacturer(7, 0x1E))
+	require.Equal(t, "Google Inc", smbios.JEP106Manufacturer(9, 0x26))
+	require.Equal(t, "SiFive Inc", smbios.JEP106Manufacturer(10, 0x89))
+	require.Equal(t, "Raspberry Pi Trading Ltd", smbios.JEP106Manufacturer(10, 0x13))
+	require.Empty(t, smbios.JEP106Manufacturer(16, 0x01))
+
+	for _, test := range []struct {
+		s        string
+		expected string
+	}{
+		{"80CE", "Samsung"},
+		{"80AD000080AD", "SK Hynix"},
+		{"002C00B3002C", "Micron Technology"},
+		{"CE00000000000000", "Samsung"},
+		{"7F7F7F0B00000000", "Nanya Technology"},
+		{"Samsung", ""},
+		{"FFFF", ""},
+	} {
+		id, ok := smbios.ParseJEP106(test.s)
+		require.Equal(t, test.expected != "", ok, test.s)
+		require.Equal(t, test.expected, id.Manufacturer(), test.s)
+	}
+
+	s := decodeTestdata(t, "Dell-PowerEdge-R630-Dual-Xeon")
+
+	for _, device := range s.MemoryDevices {
+		if device.Size != 0 {
+			require.Equal(t, "Micron Technology", device.ManufacturerName())
+		}
+	}
+
+	s = decodeTestdata(t, "Beelink-EQ12")
+	require.Equal(t, "Crucial Technology", s.MemoryDevices[0].ModuleManufacturerID.Manufacturer())
+
+	// the manufacturer field holds a name rather than a JEP106 ID
+	s = decodeTestdata(t, "SuperMicro-Dual-Xeon")
+
+	names := make([]string, 0, len(s.MemoryDevices))
+
+	for _, device := range s.MemoryDevices[:6] {
+		names = append(names, device.ManufacturerName())
+	}
+
+	require.Equal(t, []string{"Micron", "Kingston", "Micron", "Kingston", "Kingston", "Hynix Semiconductor"}, names)
+}
+
+func TestBIOSLanguageInformation(t *testing.T) {
+	t.Parallel()
+
+	s := decodeTestdata(t, "Dell-PowerEdge-R630-Dual-Xeon")
+	require.Len(t, s.BIOSLanguageInformation, 1)
+	require.Equal(t, smbios.BIOSLanguage{Language: "en", Territory: "US", Encoding: "iso8859-1"}, s.BIOSLanguageInformation[0].Current())
+
+	abbreviated := make([]byte, 0x12)
+	abbreviated[0] = 2    // installable languages
+	abbreviated[1] = 0x01 // abbreviated format
+	abbreviated[0x11] = 2 // current language
+
+	var table []byte
+
+	table = append(table, encodeStructure(13, 0x0D00, abbreviated, "enUS", "frFR", "not a language")...)
+	table = append(table, encodeStructure(13, 0x0D01, []byte{1, 0}, "en|US|iso8859-1")...) // too short for the current language
+	table = append(table, encodeStructure(127, 0xFFFF, nil)...)
+
+	s, err := smbios.Decode(bytes.NewReader(table), smbios.Version{Major: 3, Minor: 3})
+	require.NoError(t, err)
+	require.Len(t, s.BIOSLanguageInformation, 2)
+
+	b := s.BIOSLanguageInformation[0]
+	require.True(t, b.Flags.Abbreviated())
+	require.Equal(t, []string{"enUS", "frFR"}, b.InstallableLanguages)
+	require.Equal(t, []smbios.BIOSLanguage{
+		{Language: "en", Territory: "US"},
+		{Language: "fr", Territory: "FR"},
+	}, b.Languages())
+	require.Equal(t, "frFR", b.Current().String())
+
+	b = s.BIOSLanguageInformation[1]
+	require.False(t, b.Flags.Abbreviated())
+	require.Empty(t, b.CurrentLanguage)
+	require.Equal(t, "en|US|iso8859-1", b.Languages()[0].String())
+}
+
+func TestOEMStrings(t *testing.T) {
+	t.Parallel()
+
+	s := decodeTestdata(t, "HyperV")
+	require.Len(t, s.OEMStrings, 1)
+	require.False(t, s.OEMStrings[0].CountMismatch())
+	require.Empty(t, s.OEMStringPairs())
+
+	var table []byte
+
+	table = append(table, encodeStructure(11, 0x0B00, []byte{3}, "provisioning.url=https://example.com:8443/config", "Role: worker", "=invalid")...)
+	table = append(table, encodeStructure(11, 0x0B01, []byte{3}, "role=ignored", "cluster=prod")...)
+	table = append(table, encodeStructure(127, 0xFFFF, nil)...)
+
+	s, err := smbios.Decode(bytes.NewReader(table), smbios.Version{Major: 3, Minor: 3})
+	require.NoError(t, err)
+	require.Len(t, s.OEMStrings, 2)
+	require.False(t, s.OEMStrings[0].CountMismatch())
+	require.True(t, s.OEMStrings[1].CountMismatch())
+
+	require.Equal(t, []smbios.OEMStringPair{
+		{Key: "provisioning.url", Value: "https://example.com:8443/config"},
+		{Key: "Role", Value: "worker"},
+		{Key: "role", Value: "ignored"},
+		{Key: "cluster", Value: "prod"},
+	}, s.OEMStringPairs())
+
+	value, ok := s.LookupOEMString("cluster")
+	require.True(t, ok)
+	require.Equal(t, "prod", value)
+
+	value, ok = s.OEMStrings[0].Lookup("Role")
+	require.True(t, ok)
+	require.Equal(t, "worker", value)
+
+	_, ok = s.LookupOEMString("missing")
+	require.False(t, ok)
+}
+
+func TestSystemdCredentials(t *testing.T) {
+	t.Parallel()
+
+	var table []byte
+
+	table = append(table, encodeStructure(11, 0x0B00, []byte{7},
+		"io.systemd.credential:hostname=node-1",
+		"io.systemd.credential.binary:ssh.authorized_keys.root=c3NoLWVkMjU1MTkgQUFBQQ==",
+		"io.systemd.stub.kernel-cmdline-extra=console=ttyS0 quiet",
+		"io.systemd.credential:hostname=node-2",
+		"io.systemd.credential:bad/name=value",
+		"io.systemd.credential.binary:broken=!!!",
+		"unrelated=value",
+	)...)
+	table = append(table, encodeStructure(127, 0xFFFF, nil)...)
+
+	s, err := smbios.Decode(bytes.NewReader(table), smbios.Version{Major: 3, Minor: 3})
+	require.NoError(t, err)
+
+	credentials, err := s.SystemdCredentials()
+	require.Error(t, err)
+	require.ErrorContains(t, err, `"bad/name"`)
+	require.ErrorContains(t, err, `"broken"`)
+
+	require.Equal(t, []smbios.SystemdCredential{
+		{Name: "hostname", Value: []byte("node-1")},
+		{Name: "ssh.authorized_keys.root", Value: []byte("ssh-ed25519 AAAA"), Binary: true},
+	}, credentials.Credentials)
+	require.Equal(t, []string{"console=ttyS0 quiet"}, credentials.KernelCommandLineExtra)
+	require.Equal(t, []string{"hostname"}, credentials.Duplicates)
+
+	credential, ok := credentials.Lookup("hostname")
+	require.True(t, ok)
+	require.Equal(t, "node-1", string(credential.Value))
+
+	require.False(t, smbios.IsValidSystemdCredentialName(".."))
+	require.False(t, smbios.IsValidSystemdCredentialName("a:b"))
+	require.True(t, smbios.IsValidSystemdCredentialName("tpm2.pcrlock"))
+}
+
+func TestStructure(t *testing.T) {
+	t.Parallel()
+
+	s := decodeTestdata(t, "Dell-PowerEdge-R630-Dual-Xeon")
+
+	var system *smbios.Structure
+
+	for _, structure := range s.Structures {
+		if structure.Header.Type == 1 {
+			system = structure
+		}
+	}
+
+	require.NotNil(t, system)
+
+	manufacturer, err := system.StringAt(0x04)
+	require.NoError(t, err)
+	require.Equal(t, s.SystemInformation.Manufacturer, manufacturer)
+
+	wakeUpType, err := system.Byte(0x18)
+	require.NoError(t, err)
+	require.Equal(t, smbios.GetByte(system, 0x18), wakeUpType)
+
+	_, err = system.QWord(int(system.Header.Length) - 4)
+	require.ErrorIs(t, err, smbios.ErrOutOfRange)
+
+	_, err = system.Byte(0x02)
+	require.ErrorIs(t, err, smbios.ErrOutOfRange)
+
+	_, err = system.Bytes(0x04, -1)
+	require.ErrorIs(t, err, smbios.ErrOutOfRange)
+
+	vendor := &smbios.Structure{
+		Header:    smbios.Header{Type: 0xD0, Length: 0x07, Handle: 0xD000},
+		Formatted: []byte{0x01, 0x34, 0x12},
+		Strings:   []string{"OEM"},
+	}
+
+	str, err := vendor.StringAt(0x04)
+	require.NoError(t, err)
+	require.Equal(t, "OEM", str)
+
+	word, err := vendor.Word(0x05)
+	require.NoError(t, err)
+	require.Equal(t, uint16(0x1234), word)
+
+	_, err = vendor.StringAt(0x05)
+	require.ErrorIs(t, err, smbios.ErrOutOfRange)
+
+	require.Zero(t, smbios.GetByte(vendor, 0x02))
+}
+
+func TestReferences(t *testing.T) {
+	t.Parallel()
+
+	s := decodeTestdata(t, "Dell-PowerEdge-R630-Dual-Xeon")
+	require.Empty(t, s.DanglingReferences())
+
+	processor := s.ProcessorInformation[0]
+	require.Equal(t, uint8(4), s.ByHandle(processor.Handle).Header.Type)
+	require.Nil(t, s.ByHandle(0xFFF0))
+
+	index := smbios.NewHandleIndex(s.Structures)
+	require.Len(t, index, len(s.Structures))
+	require.Same(t, s.ByHandle(processor.Handle), index[processor.Handle])
+	require.Nil(t, index[0xFFF0])
+
+	references := s.References(processor.Handle)
+	require.Len(t, references, 3)
+	require.Equal(t, "L3 Cache Handle", references[2].Field)
+	require.Equal(t, uint16(processor.L3CacheHandle), references[2].To)
+
+	array := s.PhysicalMemoryArray[0]
+	incoming := s.ReferencedBy(uint16(array.Handle))
+	require.Len(t, incoming, len(s.MemoryDevices)+2)
+
+	for _, reference := range incoming[:len(s.MemoryDevices)] {
+		require.Equal(t, uint8(17), reference.FromType)
+		require.Equal(t, "Physical Memory Array Handle", reference.Field)
+	}
+
+	// the memory array mapped addresses
+	require.Equal(t, uint8(19), incoming[len(incoming)-1].FromType)
+
+	var table []byte
+
+	table = append(table, encodeStructure(4, 0x0400, []byte{1}, "CPU1")...)
+	table = append(table, encodeStructure(14, 0x0E00, []byte{1, 4, 0x00, 0x04, 17, 0x00, 0x11}, "Node 1")...)
+	table = append(table, encodeStructure(127, 0xFFFF, nil)...)
+
+	s, err := smbios.Decode(bytes.NewReader(table), smbios.Version{Major: 3, Minor: 3})
+	require.NoError(t, err)
+	require.Equal(t, []smbios.Reference{
+		{From: 0x0E00, FromType: 14, Field: "Item Handle", To: 0x1100, Dangling: true},
+	}, s.DanglingReferences())
+
+	var dot strings.Builder
+
+	require.NoError(t, s.WriteDOT(&dot))
+	require.Equal(t, `digraph smbios {
+	node [shape=box];
+	"0x0400" [label="Processor Information\n0x0400"];
+	"0x0E00" [label="Group Associations\n0x0E00"];
+	"0xFFFF" [label="End-of-Table\n0xFFFF"];
+	"0x0E00" -> "0x0400" [label="Item Handle"];
+	"0x1100" [label="missing\n0x1100", style=dashed, color=red];
+	"0x0E00" -> "0x1100" [label="Item Handle"];
+}
+`, dot.String())
+}
+
+func TestDecoder(t *testing.T) {
+	t.Parallel()
+
+	s := decodeTestdata(t, "Dell-PowerEdge-R630-Dual-Xeon")
+	require.Len(t, s.ByType(17), len(s.MemoryDevices))
+	require.Len(t, s.ByType(1), 1)
+	require.Empty(t, s.ByType(200))
+
+	stream, err := os.Open("testdata/Dell-PowerEdge-R630-Dual-Xeon.dmi")
+	require.NoError(t, err)
+
+	//nolint: errcheck
+	defer stream.Close()
+
+	d := smbios.NewDecoder(stream)
+
+	var (
+		system  *smbios.Structure
+		visited int
+	)
+
+	require.NoError(t, d.Walk(func(structure *smbios.Structure) bool {
+		visited++
+
+		if structure.Header.Type == 1 {
+			system = structure
+
+			return false
+		}
+
+		return true
+	}))
+
+	require.NotNil(t, system)
+	require.Less(t, visited, len(s.Structures))
+	require.Equal(t, s.SystemInformation, *smbios.NewSystemInformation(system, s.Version))
+
+	// the decoder resumes after the structure it stopped at
+	next, err := d.Next()
+	require.NoError(t, err)
+	require.Equal(t, s.Structures[visited], next)
+
+	require.NoError(t, d.Walk(func(*smbios.Structure) bool { return true }))
+
+	_, err = d.Next()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestFieldPresence(t *testing.T) {
+	t.Parallel()
+
+	// A SMBIOS 2.3 memory device ends with the Part Number field.
+	formatted := make([]byte, 0x1B-4)
+	binary.LittleEndian.PutUint16(formatted[0x0C-4:], 0x2000)
+	formatted[0x10-4] = 1
+	formatted[0x17-4] = 2
+
+	table := bytes.Join([][]byte{
+		encodeStructure(17, 0x0011, formatted, "DIMM 0", "Vendor"),
+		encodeStructure(127, 0xFFFF, nil),
+	}, nil)
+
+	s, err := smbios.Decode(bytes.NewReader(table), smbios.Version{Major: 2, Minor: 3})
+	require.NoError(t, err)
+	require.Len(t, s.MemoryDevices, 1)
+
+	device := s.MemoryDevices[0]
+	require.True(t, device.Has("PartNumber"))
+	require.False(t, device.Has("ConfiguredVoltage"))
+	require.Equal(t, "Vendor", device.Manufacturer)
+
+	var fields []string
+
+	for _, field := range smbios.StructureFields(17) {
+		require.Equal(t, field.Since.Major > 2 || field.Since.Minor > 3, !s.Structures[0].HasField(field), field.Name)
+
+		fields = append(fields, field.Name)
+	}
+
+	require.Equal(t, fields[5:], device.AbsentFields)
+
+	b, err := json.Marshal(device)
+	require.NoError(t, err)
+
+	var encoded map[string]any
+
+	require.NoError(t, json.Unmarshal(b, &encoded))
+
+	for _, name := range fields {
+		require.Contains(t, encoded, name)
+	}
+
+	require.Nil(t, encoded["ConfiguredVoltage"])
+	require.Equal(t, 8192.0, encoded["Size"])
+
+	var decoded smbios.MemoryDevice
+
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	require.Equal(t, device, decoded)
+
+	// Fields that follow the peer groups of a system slot move with their count.
+	slot := &smbios.Structure{
+		Header:    smbios.Header{Type: 9, Length: 0x19},
+		Formatted: make([]byte, 0x19-4),
+	}
+	slot.Formatted[0x12-4] = 1
+
+	require.True(t, slot.HasField(smbios.Field{Name: "SlotInformation", Offset: 0x13, Size: 1}))
+	require.False(t, slot.HasField(smbios.Field{Name: "SlotHeight", Offset: 0x17, Size: 1}))
+}
+
+func TestDecodeDump(t *testing.T) {
+	t.Parallel()
+
+	s := decodeTestdata(t, "Beelink-EQ12")
+	require.Equal(t, smbios.Version{Major: 3, Minor: 5}, s.Version)
+	require.False(t, s.VersionInferred)
+	require.Equal(t, "American Megatrends International, LLC.", s.BIOSInformation.Vendor)
+
+	s = decodeTestdata(t, "Dell-PowerEdge-R630-Dual-Xeon")
+	require.Equal(t, smbios.Version{Major: 2, Minor: 8}, s.Version)
+	require.True(t, s.VersionInferred)
+
+	table := bytes.Join([][]byte{
+		encodeStructure(17, 0x0011, make([]byte, 0x28-4)),
+		encodeStructure(127, 0xFFFF, nil),
+	}, nil)
+
+	// A 64-bit entry point directly followed by the table, as in sysfs.
+	ep := []byte("_SM3_\x00\x18\x03\x02\x01\x01\x00")
+	ep = binary.LittleEndian.AppendUint32(ep, uint32(len(table)))
+	ep = binary.LittleEndian.AppendUint64(ep, 0x7F000000)
+
+	var sum uint8
+
+	for _, b := range ep {
+		sum += b
+	}
+
+	ep[5] = -sum
+
+	s, err := smbios.DecodeDump(bytes.NewReader(append(ep, table...)))
+	require.NoError(t, err)
+	require.Equal(t, smbios.Version{Major: 3, Minor: 2, Revision: 1}, s.Version)
+	require.False(t, s.VersionInferred)
+	require.Len(t, s.MemoryDevices, 1)
+
+	// A legacy DMI entry point, with a BCD revision.
+	ep = []byte("_DMI_\x00")
+	ep = binary.LittleEndian.AppendUint16(ep, uint16(len(table)))
+	ep = binary.LittleEndian.AppendUint32(ep, 0x000F0000)
+	ep = binary.LittleEndian.AppendUint16(ep, 2)
+	ep = append(ep, 0x20)
+
+	sum = 0
+
+	for _, b := range ep {
+		sum += b
+	}
+
+	ep[5] = -sum
+
+	s, err = smbios.DecodeDump(bytes.NewReader(append(ep, table...)))
+	require.NoError(t, err)
+	require.IsType(t, &smbios.EntryPointLegacy{}, s.EntryPoint)
+	require.Equal(t, smbios.Version{Major: 2, Minor: 0}, s.Version)
+	require.Empty(t, s.TableDiscrepancies())
+
+	s, err = smbios.DecodeDump(bytes.NewReader(table))
+	require.NoError(t, err)
+	require.Equal(t, smbios.Version{Major: 2, Minor: 8}, s.Version)
+	require.True(t, s.VersionInferred)
+	require.Equal(t, s.Version, smbios.InferVersion(s.Structures))
+
+	for structureType, version := range map[uint8]smbios.Version{
+		40: {Major: 2, Minor: 6},
+		42: {Major: 3, Minor: 0},
+	} {
+		s, err = smbios.DecodeDump(bytes.NewReader(bytes.Join([][]byte{
+			encodeStructure(structureType, 0x0028, []byte{0}),
+			encodeStructure(127, 0xFFFF, nil),
+		}, nil)))
+		require.NoError(t, err)
+		require.Equal(t, version, s.Version)
+	}
+}
+
+func TestTableDiscrepancies(t *testing.T) {
+	t.Parallel()
+
+	s := decodeTestdata(t, "Beelink-EQ12")
+	require.IsType(t, &smbios.EntryPoint64Bit{}, s.EntryPoint)
+	require.Empty(t, s.TableDiscrepancies())
+
+	table := bytes.Join([][]byte{
+		encodeStructure(17, 0x0011, make([]byte, 0x28-4), "DIMM 0"),
+		encodeStructure(127, 0xFFFF, nil),
+	}, nil)
+
+	s, err := smbios.Decode(bytes.NewReader(table), smbios.Version{Major: 2, Minor: 8})
+	require.NoError(t, err)
+	require.Empty(t, s.TableDiscrepancies())
+
+	s.EntryPoint = &smbios.EntryPoint32Bit{
+		NumberStructures:     2,
+		StructureTableLength: uint16(len(table)),
+		MaxStructureSize:     0x28 + 8,
+	}
+	require.Empty(t, s.TableDiscrepancies())
+
+	s.EntryPoint = &smbios.EntryPoint32Bit{
+		NumberStructures:     3,
+		StructureTableLength: 0x100,
+		MaxStructureSize:     0x20,
+	}
+	require.Equal(t, []smbios.TableDiscrepancy{
+		{Field: "Number of SMBIOS Structures", EntryPoint: 3, Table: 2},
+		{Field: "Structure Table Length", EntryPoint: 0x100, Table: len(table)},
+		{Field: "Maximum Structure Size", EntryPoint: 0x20, Table: 0x28 + 8},
+	}, s.TableDiscrepancies())
+	require.Equal(t, "Number of SMBIOS Structures: entry point has 3, table has 2", s.TableDiscrepancies()[0].String())
+
+	s.EntryPoint = &smbios.EntryPoint64Bit{StructureTableMaxSize: 0x10}
+	require.Equal(t, []smbios.TableDiscrepancy{
+		{Field: "Structure Table Maximum Size", EntryPoint: 0x10, Table: len(table)},
+	}, s.TableDiscrepancies())
+
+	// The End-of-Table structure made up for a truncated table is not counted.
+	table = encodeStructure(17, 0x0011, make([]byte, 0x28-4), "DIMM 0")
+
+	s, err = smbios.Decode(bytes.NewReader(table), smbios.Version{Major: 2, Minor: 8})
+	require.NoError(t, err)
+	require.Len(t, s.Structures, 2)
+
+	s.EntryPoint = &smbios.EntryPoint32Bit{
+		NumberStructures:     1,
+		StructureTableLength: uint16(len(table)),
+		MaxStructureSize:     0x28 + 8,
+	}
+	require.Empty(t, s.TableDiscrepancies())
+}
+
+func TestDump(t *testing.T) {
+	t.Parallel()
+
+	s, err := smbios.DecodeDumpFile("testdata/Beelink-EQ12.dmi")
+	require.NoError(t, err)
+	require.Equal(t, smbios.Version{Major: 3, Minor: 5}, s.Version)
+	require.Equal(t, "American Megatrends International, LLC.", s.BIOSInformation.Vendor)
+
+	original, err := os.ReadFile("testdata/Beelink-EQ12.dmi")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	// The dump lacks the End-of-Table structure, which is written back.
+	require.NoError(t, s.WriteDump(&buf))
+	require.True(t, bytes.HasPrefix(buf.Bytes()[0x20:], original[0x20:]))
+	require.Equal(t, encodeStructure(127, 0, nil), buf.Bytes()[len(original):])
+
+	// The table maximum size is updated to the written table.
+	ep, _, err := smbios.ReadDump(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	ep64, ok := ep.(*smbios.EntryPoint64Bit)
+	require.True(t, ok)
+	require.Equal(t, uint32(buf.Len()-0x20), ep64.StructureTableMaxSize)
+
+	table := bytes.Join([][]byte{
+		encodeStructure(17, 0x0011, make([]byte, 0x28-4), "DIMM 0"),
+		encodeStructure(127, 0xFFFF, nil),
+	}, nil)
+
+	s, err = smbios.Decode(bytes.NewReader(table), smbios.Version{Major: 2, Minor: 8})
+	require.NoError(t, err)
+
+	for _, ep := range []smbios.EntryPoint{
+		nil,
+		&smbios.EntryPoint64Bit{Length: 0x18, Major: 3, Minor: 2, StructureTableMaxSize: 0x1000},
+		&smbios.EntryPointLegacy{StructureTableLength: uint16(len(table)), NumberStructures: 2, BCDRevision: 0x21},
+		// The table fields of the entry point do not match the structures being written.
+		&smbios.EntryPoint32Bit{Length: 0x1F, Major: 2, Minor: 8, NumberStructures: 5, StructureTableLength: 0x100, MaxStructureSize: 0x10},
+		&smbios.EntryPointLegacy{NumberStructures: 1, BCDRevision: 0x21},
+		&smbios.EntryPoint32Bit{Length: 0x1F, Major: 2, Minor: 8, EntryPointRevision: 0x01, FormattedArea: [5]byte{1, 2, 3, 4, 5}},
+	} {
+		s.EntryPoint = ep
+
+		buf.Reset()
+		require.NoError(t, s.WriteDump(&buf))
+
+		path := filepath.Join(t.TempDir(), "dump.bin")
+		require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+
+		decoded, err := smbios.DecodeDumpFile(path)
+		require.NoError(t, err)
+		require.Equal(t, s.Structures, decoded.Structures)
+		require.Empty(t, decoded.TableDiscrepancies())
+
+		address, _ := decoded.EntryPoint.Table()
+		require.Equal(t, 0x20, address)
+
+		// The entry point read back is written as is.
+		var rewritten bytes.Buffer
+
+		require.NoError(t, decoded.WriteDump(&rewritten))
+		require.Equal(t, buf.Bytes(), rewritten.Bytes())
+
+		if ep == nil {
+			require.IsType(t, &smbios.EntryPoint32Bit{}, decoded.EntryPoint)
+			require.Equal(t, s.Version, decoded.Version)
+		}
+	}
+
+	_, _, err = smbios.ReadDump(bytes.NewReader(table))
+	require.Error(t, err)
+
+	// The table does not fit the 16-bit length of a 32-bit entry point.
+	s, err = smbios.Decode(bytes.NewReader(bytes.Join([][]byte{
+		encodeStructure(11, 0x000B, []byte{1}, strings.Repeat("x", math.MaxUint16)),
+		encodeStructure(127, 0xFFFF, nil),
+	}, nil)), smbios.Version{Major: 2, Minor: 8})
+	require.NoError(t, err)
+	require.Error(t, s.WriteDump(io.Discard))
+
+	s.Version = smbios.Version{Major: 3, Minor: 0}
+	require.NoError(t, s.WriteDump(io.Discard))
+}
+
+func TestDMIEntries(t *testing.T) {
+	t.Parallel()
+
+	expected := decodeTestdata(t, "HyperV")
+
+	root := t.TempDir()
+	instances := map[uint8]int{}
+
+	for position, structure := range expected.Structures {
+		dir := filepath.Join(root, fmt.Sprintf("%d-%d", structure.Header.Type, instances[structure.Header.Type]))
+		instances[structure.Header.Type]++
+
+		raw := encodeStructure(structure.Header.Type, structure.Header.Handle, structure.Formatted, structure.Strings...)
+
+		require.NoError(t, os.Mkdir(dir, 0o755))
+
+		for name, value := range map[string]string{
+			"raw":      string(raw),
+			"position": fmt.Sprintf("%d\n", position),
+			"handle":   fmt.Sprintf("%d\n", structure.Header.Handle),
+			"length":   fmt.Sprintf("%d\n", raw[1]),
+		} {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(value), 0o644))
+		}
+	}
+
+	s, err := smbios.DecodeDMIEntries(root)
+	require.NoError(t, err)
+	require.True(t, s.VersionInferred)
+	require.Equal(t, expected.Version, s.Version)
+	require.Equal(t, expected.SystemInformation, s.SystemInformation)
+	require.Len(t, s.Structures, len(expected.Structures))
+
+	for i, structure := range s.Structures {
+		require.Equal(t, expected.Structures[i].Header.Handle, structure.Header.Handle)
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, "0-0", "handle"), []byte("1234\n"), 0o644))
+
+	_, err = smbios.DecodeDMIEntries(root)
+	require.ErrorContains(t, err, "does not match")
+
+	_, err = smbios.DecodeDMIEntries(t.TempDir())
+	require.Error(t, err)
+}
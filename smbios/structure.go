@@ -0,0 +1,137 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smbios
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
+)
+
+// ErrOutOfRange is returned when reading a field beyond the end of a structure,
+// or a string that the structure does not hold.
+var ErrOutOfRange = errors.New("out of range")
+
+// Header represents the header of a SMBIOS structure.
+type Header struct {
+	// Type returns the structure type.
+	Type uint8
+	// Length returns the length of the formatted area of the structure, including the header.
+	Length uint8
+	// Handle returns the structure handle.
+	Handle uint16
+}
+
+// Structure represents a raw SMBIOS structure.
+type Structure struct {
+	// Header returns the structure header.
+	Header Header
+	// Formatted returns the formatted area of the structure, without the header.
+	Formatted []byte
+	// Strings returns the strings of the structure.
+	Strings []string
+}
+
+// _NewStructure converts a decoded structure to a `Structure`.
+func _NewStructure(s *smbios.Structure) *Structure {
+	return &Structure{
+		Header: Header{
+			Type:   s.Header.Type,
+			Length: s.Header.Length,
+			Handle: s.Header.Handle,
+		},
+		Formatted: s.Formatted,
+		Strings:   s.Strings,
+	}
+}
+
+// Bytes returns the n bytes at the given offset.
+//
+// Offsets are relative to the start of the structure, as in the specification.
+// The header (offsets 00h to 03h) is only available through `Header`.
+func (s *Structure) Bytes(offset, n int) ([]byte, error) {
+	// the `Formatted` byte slice is missing the first 4 bytes of the structure that are stripped out as header info.
+	index := offset - 4
+	if index < 0 || n < 0 || index+n > len(s.Formatted) {
+		return nil, fmt.Errorf("type %d handle 0x%04X: %d bytes at offset 0x%02X: %w", s.Header.Type, s.Header.Handle, n, offset, ErrOutOfRange)
+	}
+
+	return s.Formatted[index : index+n], nil
+}
+
+// Byte returns the 8-bit unsigned integer at the given offset.
+func (s *Structure) Byte(offset int) (uint8, error) {
+	b, err := s.Bytes(offset, 1)
+	if err != nil {
+		return 0, err
+	}
+
+	return b[0], nil
+}
+
+// Word returns the 16-bit unsigned integer at the given offset.
+func (s *Structure) Word(offset int) (uint16, error) {
+	b, err := s.Bytes(offset, 2)
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.LittleEndian.Uint16(b), nil
+}
+
+// DWord returns the 32-bit unsigned integer at the given offset.
+func (s *Structure) DWord(offset int) (uint32, error) {
+	b, err := s.Bytes(offset, 4)
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+// QWord returns the 64-bit unsigned integer at the given offset.
+func (s *Structure) QWord(offset int) (uint64, error) {
+	b, err := s.Bytes(offset, 8)
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+// StringAt returns the string referenced by the string number at the given offset.
+// A string number of 0 means that there is no string, and yields an empty string.
+// The string is returned as is, unlike `GetStringOrEmpty`.
+func (s *Structure) StringAt(offset int) (string, error) {
+	index, err := s.Byte(offset)
+	if err != nil {
+		return _Empty, err
+	}
+
+	if index == 0 {
+		return _Empty, nil
+	}
+
+	if int(index) > len(s.Strings) {
+		return _Empty, fmt.Errorf("type %d handle 0x%04X: string %d at offset 0x%02X: %w", s.Header.Type, s.Header.Handle, index, offset, ErrOutOfRange)
+	}
+
+	return s.Strings[index-1], nil
+}
+
+// ByType returns the structures of the given type, in table order.
+func (s *SMBIOS) ByType(t uint8) []*Structure {
+	var structures []*Structure
+
+	for _, structure := range s.Structures {
+		if structure.Header.Type == t {
+			structures = append(structures, structure)
+		}
+	}
+
+	return structures
+}
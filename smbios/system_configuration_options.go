@@ -4,8 +4,6 @@
 
 package smbios
 
-import "github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
-
 // SystemConfigurationOptions represents the SMBIOS system configuration options.
 type SystemConfigurationOptions struct {
 	// Strings returns the actual strings.
@@ -15,7 +13,7 @@ type SystemConfigurationOptions struct {
 }
 
 // NewSystemConfigurationOptions initializes and returns a new `SystemConfigurationOptions`.
-func NewSystemConfigurationOptions(s *smbios.Structure) *SystemConfigurationOptions {
+func NewSystemConfigurationOptions(s *Structure) *SystemConfigurationOptions {
 	return &SystemConfigurationOptions{
 		Count:   GetByte(s, 0x04),
 		Strings: GetStrings(s),
@@ -4,32 +4,372 @@
 
 package smbios
 
-import "github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
-
 // SystemEnclosure represents the system enclosure.
+//
+//nolint:govet
 type SystemEnclosure struct {
 	// Manufacturer returns the system enclosure manufacturer.
 	Manufacturer string
+	// ChassisType returns the system enclosure type. See 7.4.1.
+	ChassisType ChassisType
+	// ChassisLock returns true if a chassis lock is present.
+	ChassisLock bool
 	// Version returns the system enclosure version.
 	Version string
 	// SerialNumber returns the system enclosure serial number.
 	SerialNumber string
 	// AssetTagNumber returns the system enclosure asset tag number.
 	AssetTagNumber string
+	// BootUpState returns the state of the enclosure when it was
+	// last booted. See 7.4.2.
+	BootUpState ChassisState
+	// PowerSupplyState returns the state of the enclosure's power
+	// supply (or supplies) when last booted. See 7.4.2.
+	PowerSupplyState ChassisState
+	// ThermalState returns the thermal state of the enclosure
+	// when last booted. See 7.4.2.
+	ThermalState ChassisState
+	// SecurityStatus returns the physical security status of the
+	// enclosure when last booted. See 7.4.3.
+	SecurityStatus ChassisSecurityStatus
+	// OEMDefined returns the OEM- or BIOS vendor-specific information.
+	OEMDefined uint32
+	// Height returns the height of the enclosure, in 'U's. A U is a
+	// standard unit of measure for the height of a rack or rack-mountable
+	// component and is equal to 1.75 inches or 4.445 cm.
+	// A value of 0 indicates that the enclosure height is unspecified.
+	Height uint8
+	// NumberOfPowerCords returns the number of power cords associated with
+	// the enclosure or chassis. A value of 0 indicates that the number is
+	// unspecified.
+	NumberOfPowerCords uint8
+	// ContainedElements returns the elements, possibly defined by other SMBIOS
+	// structures, present in this chassis. See 7.4.4.
+	ContainedElements []ContainedElement
 	// SKUNumber returns the system enclosure SKU number.
 	SKUNumber string
+
+	// FieldPresence returns the fields that the structure was too short to contain.
+	FieldPresence
 }
 
 // NewSystemEnclosure initializes and returns a new `SystemEnclosure`.
-func NewSystemEnclosure(s *smbios.Structure) *SystemEnclosure {
+func NewSystemEnclosure(s *Structure) *SystemEnclosure {
 	containedElementCount := GetByte(s, 0x13)
 	containedElementRecordLength := GetByte(s, 0x14)
 	n, m := int(containedElementCount), int(containedElementRecordLength)
 
+	chassisType := GetByte(s, 0x05)
+
 	return &SystemEnclosure{
-		Manufacturer: GetStringOrEmpty(s, 0x04),
-		Version:      GetStringOrEmpty(s, 0x06),
-		SerialNumber: GetStringOrEmpty(s, 0x07),
-		SKUNumber:    GetStringOrEmpty(s, 0x15+n*m),
+		Manufacturer:       GetStringOrEmpty(s, 0x04),
+		ChassisType:        ChassisType(chassisType & 0x7F),
+		ChassisLock:        IsNthBitSet(int(chassisType), 7),
+		Version:            GetStringOrEmpty(s, 0x06),
+		SerialNumber:       GetStringOrEmpty(s, 0x07),
+		AssetTagNumber:     GetStringOrEmpty(s, 0x08),
+		BootUpState:        ChassisState(GetByte(s, 0x09)),
+		PowerSupplyState:   ChassisState(GetByte(s, 0x0A)),
+		ThermalState:       ChassisState(GetByte(s, 0x0B)),
+		SecurityStatus:     ChassisSecurityStatus(GetByte(s, 0x0C)),
+		OEMDefined:         GetDWord(s, 0x0D),
+		Height:             GetByte(s, 0x11),
+		NumberOfPowerCords: GetByte(s, 0x12),
+		ContainedElements:  _GetContainedElements(s, 0x15, n, m),
+		SKUNumber:          GetStringOrEmpty(s, 0x15+n*m),
+		FieldPresence:      _GetFieldPresence(s),
+	}
+}
+
+// MarshalJSON implements `json.Marshaler`, encoding absent fields as null.
+func (e SystemEnclosure) MarshalJSON() ([]byte, error) {
+	return _MarshalJSONWithPresence(e, e.FieldPresence)
+}
+
+// ContainedElement represents a single element contained in a system enclosure.
+type ContainedElement struct {
+	// Type returns the type of element associated with this record.
+	Type ContainedElementType
+	// Minimum returns the minimum number of the element type that
+	// can be installed in the chassis for the chassis to properly
+	// operate, in the range 0 to 254.
+	Minimum uint8
+	// Maximum returns the maximum number of the element type that
+	// can be installed in the chassis, in the range 1 to 255.
+	Maximum uint8
+}
+
+func _GetContainedElements(s *Structure, offset, count, length int) []ContainedElement {
+	// Each record is at least 3 bytes long: type, minimum and maximum.
+	if count == 0 || length < 3 {
+		return nil
+	}
+
+	elements := make([]ContainedElement, 0, count)
+
+	for i := range count {
+		record := offset + i*length
+
+		elements = append(elements, ContainedElement{
+			Type:    ContainedElementType(GetByte(s, record)),
+			Minimum: GetByte(s, record+1),
+			Maximum: GetByte(s, record+2),
+		})
+	}
+
+	return elements
+}
+
+// ContainedElementType represents the type of a contained element.
+// Bit 7 selects whether bits 6:0 hold an SMBIOS structure type
+// or a baseboard type enumeration value.
+type ContainedElementType uint8
+
+// IsStructureType returns true if the element is identified by an SMBIOS structure type.
+func (c ContainedElementType) IsStructureType() bool {
+	return IsNthBitSet(int(c), 7)
+}
+
+// StructureType returns the SMBIOS structure type of the element.
+// Only meaningful when `IsStructureType` returns true.
+func (c ContainedElementType) StructureType() uint8 {
+	return uint8(c) & 0x7F
+}
+
+// BoardType returns the baseboard type of the element.
+// Only meaningful when `IsStructureType` returns false.
+func (c ContainedElementType) BoardType() BoardType {
+	return BoardType(uint8(c) & 0x7F)
+}
+
+// ChassisType represents the system enclosure or chassis type.
+type ChassisType int
+
+const (
+	// ChassisTypeOther is a chassis type.
+	ChassisTypeOther ChassisType = iota + 1
+	// ChassisTypeUnknown is a chassis type.
+	ChassisTypeUnknown
+	// ChassisTypeDesktop is a chassis type.
+	ChassisTypeDesktop
+	// ChassisTypeLowProfileDesktop is a chassis type.
+	ChassisTypeLowProfileDesktop
+	// ChassisTypePizzaBox is a chassis type.
+	ChassisTypePizzaBox
+	// ChassisTypeMiniTower is a chassis type.
+	ChassisTypeMiniTower
+	// ChassisTypeTower is a chassis type.
+	ChassisTypeTower
+	// ChassisTypePortable is a chassis type.
+	ChassisTypePortable
+	// ChassisTypeLaptop is a chassis type.
+	ChassisTypeLaptop
+	// ChassisTypeNotebook is a chassis type.
+	ChassisTypeNotebook
+	// ChassisTypeHandHeld is a chassis type.
+	ChassisTypeHandHeld
+	// ChassisTypeDockingStation is a chassis type.
+	ChassisTypeDockingStation
+	// ChassisTypeAllInOne is a chassis type.
+	ChassisTypeAllInOne
+	// ChassisTypeSubNotebook is a chassis type.
+	ChassisTypeSubNotebook
+	// ChassisTypeSpaceSaving is a chassis type.
+	ChassisTypeSpaceSaving
+	// ChassisTypeLunchBox is a chassis type.
+	ChassisTypeLunchBox
+	// ChassisTypeMainServerChassis is a chassis type.
+	ChassisTypeMainServerChassis
+	// ChassisTypeExpansionChassis is a chassis type.
+	ChassisTypeExpansionChassis
+	// ChassisTypeSubChassis is a chassis type.
+	ChassisTypeSubChassis
+	// ChassisTypeBusExpansionChassis is a chassis type.
+	ChassisTypeBusExpansionChassis
+	// ChassisTypePeripheralChassis is a chassis type.
+	ChassisTypePeripheralChassis
+	// ChassisTypeRAIDChassis is a chassis type.
+	ChassisTypeRAIDChassis
+	// ChassisTypeRackMountChassis is a chassis type.
+	ChassisTypeRackMountChassis
+	// ChassisTypeSealedCasePC is a chassis type.
+	ChassisTypeSealedCasePC
+	// ChassisTypeMultiSystemChassis is a chassis type.
+	ChassisTypeMultiSystemChassis
+	// ChassisTypeCompactPCI is a chassis type.
+	ChassisTypeCompactPCI
+	// ChassisTypeAdvancedTCA is a chassis type.
+	ChassisTypeAdvancedTCA
+	// ChassisTypeBlade is a chassis type.
+	ChassisTypeBlade
+	// ChassisTypeBladeEnclosure is a chassis type.
+	ChassisTypeBladeEnclosure
+	// ChassisTypeTablet is a chassis type.
+	ChassisTypeTablet
+	// ChassisTypeConvertible is a chassis type.
+	ChassisTypeConvertible
+	// ChassisTypeDetachable is a chassis type.
+	ChassisTypeDetachable
+	// ChassisTypeIoTGateway is a chassis type.
+	ChassisTypeIoTGateway
+	// ChassisTypeEmbeddedPC is a chassis type.
+	ChassisTypeEmbeddedPC
+	// ChassisTypeMiniPC is a chassis type.
+	ChassisTypeMiniPC
+	// ChassisTypeStickPC is a chassis type.
+	ChassisTypeStickPC
+)
+
+// String returns the string representation of a `ChassisType`.
+//
+//nolint:gocyclo,cyclop
+func (c ChassisType) String() string {
+	switch c {
+	case ChassisTypeOther:
+		return _Other
+	case ChassisTypeUnknown:
+		return _Unknown
+	case ChassisTypeDesktop:
+		return "Desktop"
+	case ChassisTypeLowProfileDesktop:
+		return "Low Profile Desktop"
+	case ChassisTypePizzaBox:
+		return "Pizza Box"
+	case ChassisTypeMiniTower:
+		return "Mini Tower"
+	case ChassisTypeTower:
+		return "Tower"
+	case ChassisTypePortable:
+		return "Portable"
+	case ChassisTypeLaptop:
+		return "Laptop"
+	case ChassisTypeNotebook:
+		return "Notebook"
+	case ChassisTypeHandHeld:
+		return "Hand Held"
+	case ChassisTypeDockingStation:
+		return "Docking Station"
+	case ChassisTypeAllInOne:
+		return "All in One"
+	case ChassisTypeSubNotebook:
+		return "Sub Notebook"
+	case ChassisTypeSpaceSaving:
+		return "Space-saving"
+	case ChassisTypeLunchBox:
+		return "Lunch Box"
+	case ChassisTypeMainServerChassis:
+		return "Main Server Chassis"
+	case ChassisTypeExpansionChassis:
+		return "Expansion Chassis"
+	case ChassisTypeSubChassis:
+		return "SubChassis"
+	case ChassisTypeBusExpansionChassis:
+		return "Bus Expansion Chassis"
+	case ChassisTypePeripheralChassis:
+		return "Peripheral Chassis"
+	case ChassisTypeRAIDChassis:
+		return "RAID Chassis"
+	case ChassisTypeRackMountChassis:
+		return "Rack Mount Chassis"
+	case ChassisTypeSealedCasePC:
+		return "Sealed-case PC"
+	case ChassisTypeMultiSystemChassis:
+		return "Multi-system chassis"
+	case ChassisTypeCompactPCI:
+		return "Compact PCI"
+	case ChassisTypeAdvancedTCA:
+		return "Advanced TCA"
+	case ChassisTypeBlade:
+		return "Blade"
+	case ChassisTypeBladeEnclosure:
+		return "Blade Enclosure"
+	case ChassisTypeTablet:
+		return "Tablet"
+	case ChassisTypeConvertible:
+		return "Convertible"
+	case ChassisTypeDetachable:
+		return "Detachable"
+	case ChassisTypeIoTGateway:
+		return "IoT Gateway"
+	case ChassisTypeEmbeddedPC:
+		return "Embedded PC"
+	case ChassisTypeMiniPC:
+		return "Mini PC"
+	case ChassisTypeStickPC:
+		return "Stick PC"
+	}
+
+	return _Unknown
+}
+
+// ChassisState represents the system enclosure or chassis state.
+type ChassisState int
+
+const (
+	// ChassisStateOther is a chassis state.
+	ChassisStateOther ChassisState = iota + 1
+	// ChassisStateUnknown is a chassis state.
+	ChassisStateUnknown
+	// ChassisStateSafe is a chassis state.
+	ChassisStateSafe
+	// ChassisStateWarning is a chassis state.
+	ChassisStateWarning
+	// ChassisStateCritical is a chassis state.
+	ChassisStateCritical
+	// ChassisStateNonRecoverable is a chassis state.
+	ChassisStateNonRecoverable
+)
+
+// String returns the string representation of a `ChassisState`.
+func (c ChassisState) String() string {
+	switch c {
+	case ChassisStateOther:
+		return _Other
+	case ChassisStateUnknown:
+		return _Unknown
+	case ChassisStateSafe:
+		return "Safe"
+	case ChassisStateWarning:
+		return "Warning"
+	case ChassisStateCritical:
+		return "Critical"
+	case ChassisStateNonRecoverable:
+		return "Non-recoverable"
+	}
+
+	return _Unknown
+}
+
+// ChassisSecurityStatus represents the system enclosure or chassis security status.
+type ChassisSecurityStatus int
+
+const (
+	// ChassisSecurityStatusOther is a chassis security status.
+	ChassisSecurityStatusOther ChassisSecurityStatus = iota + 1
+	// ChassisSecurityStatusUnknown is a chassis security status.
+	ChassisSecurityStatusUnknown
+	// ChassisSecurityStatusNone is a chassis security status.
+	ChassisSecurityStatusNone
+	// ChassisSecurityStatusExternalInterfaceLockedOut is a chassis security status.
+	ChassisSecurityStatusExternalInterfaceLockedOut
+	// ChassisSecurityStatusExternalInterfaceEnabled is a chassis security status.
+	ChassisSecurityStatusExternalInterfaceEnabled
+)
+
+// String returns the string representation of a `ChassisSecurityStatus`.
+func (c ChassisSecurityStatus) String() string {
+	switch c {
+	case ChassisSecurityStatusOther:
+		return _Other
+	case ChassisSecurityStatusUnknown:
+		return _Unknown
+	case ChassisSecurityStatusNone:
+		return "None"
+	case ChassisSecurityStatusExternalInterfaceLockedOut:
+		return "External interface locked out"
+	case ChassisSecurityStatusExternalInterfaceEnabled:
+		return "External interface enabled"
 	}
+
+	return _Unknown
 }
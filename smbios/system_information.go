@@ -10,8 +10,6 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
-
-	"github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
 )
 
 // SystemInformation represents the SMBIOS system information.
@@ -35,10 +33,13 @@ type SystemInformation struct {
 	SKUNumber string
 	// Family returns the system family.
 	Family string
+
+	// FieldPresence returns the fields that the structure was too short to contain.
+	FieldPresence
 }
 
 // NewSystemInformation initializes and returns a new `SystemInformation`.
-func NewSystemInformation(s *smbios.Structure, v Version) *SystemInformation {
+func NewSystemInformation(s *Structure, v Version) *SystemInformation {
 	uuidString := ""
 
 	uid, err := GetUUID(v, s)
@@ -48,17 +49,23 @@ func NewSystemInformation(s *smbios.Structure, v Version) *SystemInformation {
 	}
 
 	return &SystemInformation{
-		Manufacturer: GetStringOrEmpty(s, 0x04),
-		ProductName:  GetStringOrEmpty(s, 0x05),
-		Version:      GetStringOrEmpty(s, 0x06),
-		SerialNumber: GetStringOrEmpty(s, 0x07),
-		UUID:         uuidString,
-		WakeUpType:   WakeUpType(GetByte(s, 0x18)),
-		SKUNumber:    GetStringOrEmpty(s, 0x19),
-		Family:       GetStringOrEmpty(s, 0x1A),
+		Manufacturer:  GetStringOrEmpty(s, 0x04),
+		ProductName:   GetStringOrEmpty(s, 0x05),
+		Version:       GetStringOrEmpty(s, 0x06),
+		SerialNumber:  GetStringOrEmpty(s, 0x07),
+		UUID:          uuidString,
+		WakeUpType:    WakeUpType(GetByte(s, 0x18)),
+		SKUNumber:     GetStringOrEmpty(s, 0x19),
+		Family:        GetStringOrEmpty(s, 0x1A),
+		FieldPresence: _GetFieldPresence(s),
 	}
 }
 
+// MarshalJSON implements `json.Marshaler`, encoding absent fields as null.
+func (s SystemInformation) MarshalJSON() ([]byte, error) {
+	return _MarshalJSONWithPresence(s, s.FieldPresence)
+}
+
 // WakeUpType defines the Wake-up type enum.
 type WakeUpType int
 
@@ -111,7 +118,7 @@ func (w WakeUpType) String() string {
 // GetUUID returns the system Universal Unique ID number.
 // Return middle endian only if SMBIOS version >= 2.6.
 // Reference: http://dnaeon.github.io/convert-big-endian-uuid-to-middle-endian/
-func GetUUID(v Version, s *smbios.Structure) (uid uuid.UUID, err error) {
+func GetUUID(v Version, s *Structure) (uid uuid.UUID, err error) {
 	var b []byte
 	if v.Major >= 3 || (v.Major == 2 && v.Minor >= 6) {
 		b, err = toMiddleEndian(s.Formatted)
@@ -4,17 +4,733 @@
 
 package smbios
 
-import "github.com/siderolabs/go-smbios/smbios/internal/github.com/digitalocean/go-smbios/smbios"
+import (
+	"fmt"
+	"strings"
+)
 
 // SystemSlot represents a SMBIOS system slot.
+//
+//nolint:govet
 type SystemSlot struct {
 	// SlotDesignation returns the slot designation.
 	SlotDesignation string
+	// SlotType returns the slot type. See 7.10.1.
+	SlotType SlotType
+	// SlotDataBusWidth returns the slot data bus width. See 7.10.2.
+	SlotDataBusWidth SlotWidth
+	// CurrentUsage returns the slot current usage. See 7.10.3.
+	CurrentUsage SlotUsage
+	// SlotLength returns the slot length. See 7.10.4.
+	SlotLength SlotLength
+	// SlotID returns the slot ID. The interpretation of the value
+	// depends on the slot type. See 7.10.5.
+	SlotID uint16
+	// SlotCharacteristics1 returns the slot characteristics. See 7.10.6.
+	SlotCharacteristics1 SlotCharacteristics1
+	// SlotCharacteristics2 returns additional slot characteristics. See 7.10.7.
+	SlotCharacteristics2 SlotCharacteristics2
+	// SegmentGroupNumber returns the PCI segment group number of the slot.
+	// For slots without bus/device/function information, the value is FFFFh.
+	SegmentGroupNumber uint16
+	// BusNumber returns the PCI bus number of the slot.
+	// For slots without bus/device/function information, the value is FFh.
+	BusNumber uint8
+	// DeviceFunctionNumber returns the PCI device and function number of the slot.
+	// For slots without bus/device/function information, the value is FFh.
+	DeviceFunctionNumber DeviceFunctionNumber
+	// DataBusWidth returns the electrical bus width of the base
+	// segment/bus/device/function.
+	DataBusWidth uint8
+	// PeerGroups returns the segment/bus/device/function/width groups
+	// of the peer devices of a bifurcated slot.
+	PeerGroups []SlotPeerGroup
+	// SlotInformation returns additional slot information,
+	// for PCI Express slots the PCI Express generation.
+	SlotInformation uint8
+	// SlotPhysicalWidth returns the physical width of the slot. See 7.10.2.
+	SlotPhysicalWidth SlotWidth
+	// SlotPitch returns the pitch of the slot, in units of 1/100 millimeter.
+	// A value of 0 indicates that the pitch is not given or is unknown.
+	SlotPitch uint16
+	// SlotHeight returns the maximum supported card height for the slot. See 7.10.9.
+	SlotHeight SlotHeight
+
+	// FieldPresence returns the fields that the structure was too short to contain.
+	FieldPresence
 }
 
 // NewSystemSlot initializes and returns a new `SystemSlot`.
-func NewSystemSlot(s *smbios.Structure) *SystemSlot {
+func NewSystemSlot(s *Structure) *SystemSlot {
+	peerGroupingCount := int(GetByte(s, 0x12))
+	n := peerGroupingCount * 5
+
 	return &SystemSlot{
-		SlotDesignation: GetStringOrEmpty(s, 0x04),
+		SlotDesignation:      GetStringOrEmpty(s, 0x04),
+		SlotType:             SlotType(GetByte(s, 0x05)),
+		SlotDataBusWidth:     SlotWidth(GetByte(s, 0x06)),
+		CurrentUsage:         SlotUsage(GetByte(s, 0x07)),
+		SlotLength:           SlotLength(GetByte(s, 0x08)),
+		SlotID:               GetWord(s, 0x09),
+		SlotCharacteristics1: SlotCharacteristics1(GetByte(s, 0x0B)),
+		SlotCharacteristics2: SlotCharacteristics2(GetByte(s, 0x0C)),
+		SegmentGroupNumber:   GetWord(s, 0x0D),
+		BusNumber:            GetByte(s, 0x0F),
+		DeviceFunctionNumber: DeviceFunctionNumber(GetByte(s, 0x10)),
+		DataBusWidth:         GetByte(s, 0x11),
+		PeerGroups:           _GetSlotPeerGroups(s, 0x13, peerGroupingCount),
+		SlotInformation:      GetByte(s, 0x13+n),
+		SlotPhysicalWidth:    SlotWidth(GetByte(s, 0x14+n)),
+		SlotPitch:            GetWord(s, 0x15+n),
+		SlotHeight:           SlotHeight(GetByte(s, 0x17+n)),
+		FieldPresence:        _GetFieldPresence(s),
+	}
+}
+
+// MarshalJSON implements `json.Marshaler`, encoding absent fields as null.
+func (s SystemSlot) MarshalJSON() ([]byte, error) {
+	return _MarshalJSONWithPresence(s, s.FieldPresence)
+}
+
+// HasPCIAddress returns true if the slot provides segment/bus/device/function information.
+// Structures older than SMBIOS 2.6 do not contain these fields.
+func (s SystemSlot) HasPCIAddress() bool {
+	if !s.Has("SegmentGroupNumber") || !s.Has("DeviceFunctionNumber") {
+		return false
+	}
+
+	return s.SegmentGroupNumber != 0xFFFF && s.BusNumber != 0xFF && s.DeviceFunctionNumber != 0xFF
+}
+
+// PCIAddress returns the PCI address of the slot.
+func (s SystemSlot) PCIAddress() PCIAddress {
+	return PCIAddress{
+		Segment:  s.SegmentGroupNumber,
+		Bus:      s.BusNumber,
+		Device:   s.DeviceFunctionNumber.Device(),
+		Function: s.DeviceFunctionNumber.Function(),
+	}
+}
+
+// Width returns the physical width of the slot if it is provided,
+// otherwise the slot data bus width.
+func (s SystemSlot) Width() SlotWidth {
+	if s.SlotPhysicalWidth != 0 {
+		return s.SlotPhysicalWidth
+	}
+
+	return s.SlotDataBusWidth
+}
+
+// SlotPeerGroup represents the segment/bus/device/function/width of a slot peer.
+type SlotPeerGroup struct {
+	// SegmentGroupNumber returns the PCI segment group number of the peer.
+	SegmentGroupNumber uint16
+	// BusNumber returns the PCI bus number of the peer.
+	BusNumber uint8
+	// DeviceFunctionNumber returns the PCI device and function number of the peer.
+	DeviceFunctionNumber DeviceFunctionNumber
+	// DataBusWidth returns the electrical bus width of the peer.
+	DataBusWidth uint8
+}
+
+// PCIAddress returns the PCI address of the peer.
+func (p SlotPeerGroup) PCIAddress() PCIAddress {
+	return PCIAddress{
+		Segment:  p.SegmentGroupNumber,
+		Bus:      p.BusNumber,
+		Device:   p.DeviceFunctionNumber.Device(),
+		Function: p.DeviceFunctionNumber.Function(),
+	}
+}
+
+func _GetSlotPeerGroups(s *Structure, offset, count int) []SlotPeerGroup {
+	if count == 0 {
+		return nil
+	}
+
+	groups := make([]SlotPeerGroup, 0, count)
+
+	for i := range count {
+		group := offset + i*5
+
+		groups = append(groups, SlotPeerGroup{
+			SegmentGroupNumber:   GetWord(s, group),
+			BusNumber:            GetByte(s, group+2),
+			DeviceFunctionNumber: DeviceFunctionNumber(GetByte(s, group+3)),
+			DataBusWidth:         GetByte(s, group+4),
+		})
+	}
+
+	return groups
+}
+
+// DeviceFunctionNumber represents a PCI device and function number.
+// Bits 7:3 hold the device number and bits 2:0 hold the function number.
+type DeviceFunctionNumber uint8
+
+// Device returns the PCI device number.
+func (d DeviceFunctionNumber) Device() uint8 {
+	return uint8(d) >> 3
+}
+
+// Function returns the PCI function number.
+func (d DeviceFunctionNumber) Function() uint8 {
+	return uint8(d) & 0x07
+}
+
+// PCIAddress represents a PCI segment group, bus, device and function.
+type PCIAddress struct {
+	Segment  uint16
+	Bus      uint8
+	Device   uint8
+	Function uint8
+}
+
+// String returns the string representation of a `PCIAddress`,
+// in the format used by Linux, for example "0000:3b:00.0".
+func (p PCIAddress) String() string {
+	return fmt.Sprintf("%04x:%02x:%02x.%x", p.Segment, p.Bus, p.Device, p.Function)
+}
+
+// SlotType represents the system slot type.
+type SlotType int
+
+const (
+	// SlotTypeOther is a slot type.
+	SlotTypeOther SlotType = 0x01
+	// SlotTypeUnknown is a slot type.
+	SlotTypeUnknown SlotType = 0x02
+	// SlotTypeISA is a slot type.
+	SlotTypeISA SlotType = 0x03
+	// SlotTypeMCA is a slot type.
+	SlotTypeMCA SlotType = 0x04
+	// SlotTypeEISA is a slot type.
+	SlotTypeEISA SlotType = 0x05
+	// SlotTypePCI is a slot type.
+	SlotTypePCI SlotType = 0x06
+	// SlotTypePCCard is a slot type.
+	SlotTypePCCard SlotType = 0x07
+	// SlotTypeVLVESA is a slot type.
+	SlotTypeVLVESA SlotType = 0x08
+	// SlotTypeProprietary is a slot type.
+	SlotTypeProprietary SlotType = 0x09
+	// SlotTypeProcessorCardSlot is a slot type.
+	SlotTypeProcessorCardSlot SlotType = 0x0A
+	// SlotTypeProprietaryMemoryCardSlot is a slot type.
+	SlotTypeProprietaryMemoryCardSlot SlotType = 0x0B
+	// SlotTypeIORiserCardSlot is a slot type.
+	SlotTypeIORiserCardSlot SlotType = 0x0C
+	// SlotTypeNuBus is a slot type.
+	SlotTypeNuBus SlotType = 0x0D
+	// SlotTypePCI66MHzCapable is a slot type.
+	SlotTypePCI66MHzCapable SlotType = 0x0E
+	// SlotTypeAGP is a slot type.
+	SlotTypeAGP SlotType = 0x0F
+	// SlotTypeAGP2X is a slot type.
+	SlotTypeAGP2X SlotType = 0x10
+	// SlotTypeAGP4X is a slot type.
+	SlotTypeAGP4X SlotType = 0x11
+	// SlotTypePCIX is a slot type.
+	SlotTypePCIX SlotType = 0x12
+	// SlotTypeAGP8X is a slot type.
+	SlotTypeAGP8X SlotType = 0x13
+	// SlotTypeM2Socket1DP is a slot type.
+	SlotTypeM2Socket1DP SlotType = 0x14
+	// SlotTypeM2Socket1SD is a slot type.
+	SlotTypeM2Socket1SD SlotType = 0x15
+	// SlotTypeM2Socket2 is a slot type.
+	SlotTypeM2Socket2 SlotType = 0x16
+	// SlotTypeM2Socket3 is a slot type.
+	SlotTypeM2Socket3 SlotType = 0x17
+	// SlotTypeMXMTypeI is a slot type.
+	SlotTypeMXMTypeI SlotType = 0x18
+	// SlotTypeMXMTypeII is a slot type.
+	SlotTypeMXMTypeII SlotType = 0x19
+	// SlotTypeMXMTypeIIIStandard is a slot type.
+	SlotTypeMXMTypeIIIStandard SlotType = 0x1A
+	// SlotTypeMXMTypeIIIHE is a slot type.
+	SlotTypeMXMTypeIIIHE SlotType = 0x1B
+	// SlotTypeMXMTypeIV is a slot type.
+	SlotTypeMXMTypeIV SlotType = 0x1C
+	// SlotTypeMXM30TypeA is a slot type.
+	SlotTypeMXM30TypeA SlotType = 0x1D
+	// SlotTypeMXM30TypeB is a slot type.
+	SlotTypeMXM30TypeB SlotType = 0x1E
+	// SlotTypePCIExpressGen2SFF8639 is a slot type.
+	SlotTypePCIExpressGen2SFF8639 SlotType = 0x1F
+	// SlotTypePCIExpressGen3SFF8639 is a slot type.
+	SlotTypePCIExpressGen3SFF8639 SlotType = 0x20
+	// SlotTypePCIExpressMini52PinWithKeepOuts is a slot type.
+	SlotTypePCIExpressMini52PinWithKeepOuts SlotType = 0x21
+	// SlotTypePCIExpressMini52PinWithoutKeepOuts is a slot type.
+	SlotTypePCIExpressMini52PinWithoutKeepOuts SlotType = 0x22
+	// SlotTypePCIExpressMini76Pin is a slot type.
+	SlotTypePCIExpressMini76Pin SlotType = 0x23
+	// SlotTypePCIExpressGen4SFF8639 is a slot type.
+	SlotTypePCIExpressGen4SFF8639 SlotType = 0x24
+	// SlotTypePCIExpressGen5SFF8639 is a slot type.
+	SlotTypePCIExpressGen5SFF8639 SlotType = 0x25
+	// SlotTypeOCPNIC30SFF is a slot type.
+	SlotTypeOCPNIC30SFF SlotType = 0x26
+	// SlotTypeOCPNIC30LFF is a slot type.
+	SlotTypeOCPNIC30LFF SlotType = 0x27
+	// SlotTypeOCPNICPriorTo30 is a slot type.
+	SlotTypeOCPNICPriorTo30 SlotType = 0x28
+	// SlotTypeCXLFlexbus10 is a slot type.
+	SlotTypeCXLFlexbus10 SlotType = 0x30
+	// SlotTypePC98C20 is a slot type.
+	SlotTypePC98C20 SlotType = 0xA0
+	// SlotTypePC98C24 is a slot type.
+	SlotTypePC98C24 SlotType = 0xA1
+	// SlotTypePC98E is a slot type.
+	SlotTypePC98E SlotType = 0xA2
+	// SlotTypePC98LocalBus is a slot type.
+	SlotTypePC98LocalBus SlotType = 0xA3
+	// SlotTypePC98Card is a slot type.
+	SlotTypePC98Card SlotType = 0xA4
+	// SlotTypePCIExpress is a slot type.
+	SlotTypePCIExpress SlotType = 0xA5
+	// SlotTypePCIExpressX1 is a slot type.
+	SlotTypePCIExpressX1 SlotType = 0xA6
+	// SlotTypePCIExpressX2 is a slot type.
+	SlotTypePCIExpressX2 SlotType = 0xA7
+	// SlotTypePCIExpressX4 is a slot type.
+	SlotTypePCIExpressX4 SlotType = 0xA8
+	// SlotTypePCIExpressX8 is a slot type.
+	SlotTypePCIExpressX8 SlotType = 0xA9
+	// SlotTypePCIExpressX16 is a slot type.
+	SlotTypePCIExpressX16 SlotType = 0xAA
+	// SlotTypePCIExpressGen2 is a slot type.
+	SlotTypePCIExpressGen2 SlotType = 0xAB
+	// SlotTypePCIExpressGen2X1 is a slot type.
+	SlotTypePCIExpressGen2X1 SlotType = 0xAC
+	// SlotTypePCIExpressGen2X2 is a slot type.
+	SlotTypePCIExpressGen2X2 SlotType = 0xAD
+	// SlotTypePCIExpressGen2X4 is a slot type.
+	SlotTypePCIExpressGen2X4 SlotType = 0xAE
+	// SlotTypePCIExpressGen2X8 is a slot type.
+	SlotTypePCIExpressGen2X8 SlotType = 0xAF
+	// SlotTypePCIExpressGen2X16 is a slot type.
+	SlotTypePCIExpressGen2X16 SlotType = 0xB0
+	// SlotTypePCIExpressGen3 is a slot type.
+	SlotTypePCIExpressGen3 SlotType = 0xB1
+	// SlotTypePCIExpressGen3X1 is a slot type.
+	SlotTypePCIExpressGen3X1 SlotType = 0xB2
+	// SlotTypePCIExpressGen3X2 is a slot type.
+	SlotTypePCIExpressGen3X2 SlotType = 0xB3
+	// SlotTypePCIExpressGen3X4 is a slot type.
+	SlotTypePCIExpressGen3X4 SlotType = 0xB4
+	// SlotTypePCIExpressGen3X8 is a slot type.
+	SlotTypePCIExpressGen3X8 SlotType = 0xB5
+	// SlotTypePCIExpressGen3X16 is a slot type.
+	SlotTypePCIExpressGen3X16 SlotType = 0xB6
+	// SlotTypePCIExpressGen4 is a slot type.
+	SlotTypePCIExpressGen4 SlotType = 0xB8
+	// SlotTypePCIExpressGen4X1 is a slot type.
+	SlotTypePCIExpressGen4X1 SlotType = 0xB9
+	// SlotTypePCIExpressGen4X2 is a slot type.
+	SlotTypePCIExpressGen4X2 SlotType = 0xBA
+	// SlotTypePCIExpressGen4X4 is a slot type.
+	SlotTypePCIExpressGen4X4 SlotType = 0xBB
+	// SlotTypePCIExpressGen4X8 is a slot type.
+	SlotTypePCIExpressGen4X8 SlotType = 0xBC
+	// SlotTypePCIExpressGen4X16 is a slot type.
+	SlotTypePCIExpressGen4X16 SlotType = 0xBD
+	// SlotTypePCIExpressGen5 is a slot type.
+	SlotTypePCIExpressGen5 SlotType = 0xBE
+	// SlotTypePCIExpressGen5X1 is a slot type.
+	SlotTypePCIExpressGen5X1 SlotType = 0xBF
+	// SlotTypePCIExpressGen5X2 is a slot type.
+	SlotTypePCIExpressGen5X2 SlotType = 0xC0
+	// SlotTypePCIExpressGen5X4 is a slot type.
+	SlotTypePCIExpressGen5X4 SlotType = 0xC1
+	// SlotTypePCIExpressGen5X8 is a slot type.
+	SlotTypePCIExpressGen5X8 SlotType = 0xC2
+	// SlotTypePCIExpressGen5X16 is a slot type.
+	SlotTypePCIExpressGen5X16 SlotType = 0xC3
+	// SlotTypePCIExpressGen6AndBeyond is a slot type.
+	SlotTypePCIExpressGen6AndBeyond SlotType = 0xC4
+	// SlotTypeEDSFFE1 is a slot type.
+	SlotTypeEDSFFE1 SlotType = 0xC5
+	// SlotTypeEDSFFE3 is a slot type.
+	SlotTypeEDSFFE3 SlotType = 0xC6
+)
+
+// String returns the string representation of a `SlotType`.
+func (s SlotType) String() string {
+	if name, ok := slotTypes[s]; ok {
+		return name
+	}
+
+	return _Unknown
+}
+
+// IsPCIe returns true if the slot type is a PCI Express slot,
+// including form factors that carry PCI Express lanes.
+func (s SlotType) IsPCIe() bool {
+	switch {
+	case s >= SlotTypePCIExpress && s <= SlotTypeEDSFFE3:
+		return true
+	case s >= SlotTypeM2Socket1DP && s <= SlotTypeM2Socket3,
+		s >= SlotTypePCIExpressGen2SFF8639 && s <= SlotTypeCXLFlexbus10:
+		return true
+	}
+
+	return false
+}
+
+var slotTypes = map[SlotType]string{
+	SlotTypeOther:                           _Other,
+	SlotTypeUnknown:                         _Unknown,
+	SlotTypeISA:                             "ISA",
+	SlotTypeMCA:                             "MCA",
+	SlotTypeEISA:                            "EISA",
+	SlotTypePCI:                             "PCI",
+	SlotTypePCCard:                          "PC Card (PCMCIA)",
+	SlotTypeVLVESA:                          "VL-VESA",
+	SlotTypeProprietary:                     "Proprietary",
+	SlotTypeProcessorCardSlot:               "Processor Card Slot",
+	SlotTypeProprietaryMemoryCardSlot:       "Proprietary Memory Card Slot",
+	SlotTypeIORiserCardSlot:                 "I/O Riser Card Slot",
+	SlotTypeNuBus:                           "NuBus",
+	SlotTypePCI66MHzCapable:                 "PCI - 66MHz Capable",
+	SlotTypeAGP:                             "AGP",
+	SlotTypeAGP2X:                           "AGP 2X",
+	SlotTypeAGP4X:                           "AGP 4X",
+	SlotTypePCIX:                            "PCI-X",
+	SlotTypeAGP8X:                           "AGP 8X",
+	SlotTypeM2Socket1DP:                     "M.2 Socket 1-DP (Mechanical Key A)",
+	SlotTypeM2Socket1SD:                     "M.2 Socket 1-SD (Mechanical Key E)",
+	SlotTypeM2Socket2:                       "M.2 Socket 2 (Mechanical Key B)",
+	SlotTypeM2Socket3:                       "M.2 Socket 3 (Mechanical Key M)",
+	SlotTypeMXMTypeI:                        "MXM Type I",
+	SlotTypeMXMTypeII:                       "MXM Type II",
+	SlotTypeMXMTypeIIIStandard:              "MXM Type III (standard connector)",
+	SlotTypeMXMTypeIIIHE:                    "MXM Type III (HE connector)",
+	SlotTypeMXMTypeIV:                       "MXM Type IV",
+	SlotTypeMXM30TypeA:                      "MXM 3.0 Type A",
+	SlotTypeMXM30TypeB:                      "MXM 3.0 Type B",
+	SlotTypePCIExpressGen2SFF8639:           "PCI Express Gen 2 SFF-8639 (U.2)",
+	SlotTypePCIExpressGen3SFF8639:           "PCI Express Gen 3 SFF-8639 (U.2)",
+	SlotTypePCIExpressMini52PinWithKeepOuts: "PCI Express Mini 52-pin (CEM spec. 2.0) with bottom-side keep-outs",
+	SlotTypePCIExpressMini52PinWithoutKeepOuts: "PCI Express Mini 52-pin (CEM spec. 2.0) without bottom-side keep-outs",
+	SlotTypePCIExpressMini76Pin:                "PCI Express Mini 76-pin (CEM spec. 2.0)",
+	SlotTypePCIExpressGen4SFF8639:              "PCI Express Gen 4 SFF-8639 (U.2)",
+	SlotTypePCIExpressGen5SFF8639:              "PCI Express Gen 5 SFF-8639 (U.2)",
+	SlotTypeOCPNIC30SFF:                        "OCP NIC 3.0 Small Form Factor (SFF)",
+	SlotTypeOCPNIC30LFF:                        "OCP NIC 3.0 Large Form Factor (LFF)",
+	SlotTypeOCPNICPriorTo30:                    "OCP NIC Prior to 3.0",
+	SlotTypeCXLFlexbus10:                       "CXL Flexbus 1.0",
+	SlotTypePC98C20:                            "PC-98/C20",
+	SlotTypePC98C24:                            "PC-98/C24",
+	SlotTypePC98E:                              "PC-98/E",
+	SlotTypePC98LocalBus:                       "PC-98/Local Bus",
+	SlotTypePC98Card:                           "PC-98/Card",
+	SlotTypePCIExpress:                         "PCI Express",
+	SlotTypePCIExpressX1:                       "PCI Express x1",
+	SlotTypePCIExpressX2:                       "PCI Express x2",
+	SlotTypePCIExpressX4:                       "PCI Express x4",
+	SlotTypePCIExpressX8:                       "PCI Express x8",
+	SlotTypePCIExpressX16:                      "PCI Express x16",
+	SlotTypePCIExpressGen2:                     "PCI Express Gen 2",
+	SlotTypePCIExpressGen2X1:                   "PCI Express Gen 2 x1",
+	SlotTypePCIExpressGen2X2:                   "PCI Express Gen 2 x2",
+	SlotTypePCIExpressGen2X4:                   "PCI Express Gen 2 x4",
+	SlotTypePCIExpressGen2X8:                   "PCI Express Gen 2 x8",
+	SlotTypePCIExpressGen2X16:                  "PCI Express Gen 2 x16",
+	SlotTypePCIExpressGen3:                     "PCI Express Gen 3",
+	SlotTypePCIExpressGen3X1:                   "PCI Express Gen 3 x1",
+	SlotTypePCIExpressGen3X2:                   "PCI Express Gen 3 x2",
+	SlotTypePCIExpressGen3X4:                   "PCI Express Gen 3 x4",
+	SlotTypePCIExpressGen3X8:                   "PCI Express Gen 3 x8",
+	SlotTypePCIExpressGen3X16:                  "PCI Express Gen 3 x16",
+	SlotTypePCIExpressGen4:                     "PCI Express Gen 4",
+	SlotTypePCIExpressGen4X1:                   "PCI Express Gen 4 x1",
+	SlotTypePCIExpressGen4X2:                   "PCI Express Gen 4 x2",
+	SlotTypePCIExpressGen4X4:                   "PCI Express Gen 4 x4",
+	SlotTypePCIExpressGen4X8:                   "PCI Express Gen 4 x8",
+	SlotTypePCIExpressGen4X16:                  "PCI Express Gen 4 x16",
+	SlotTypePCIExpressGen5:                     "PCI Express Gen 5",
+	SlotTypePCIExpressGen5X1:                   "PCI Express Gen 5 x1",
+	SlotTypePCIExpressGen5X2:                   "PCI Express Gen 5 x2",
+	SlotTypePCIExpressGen5X4:                   "PCI Express Gen 5 x4",
+	SlotTypePCIExpressGen5X8:                   "PCI Express Gen 5 x8",
+	SlotTypePCIExpressGen5X16:                  "PCI Express Gen 5 x16",
+	SlotTypePCIExpressGen6AndBeyond:            "PCI Express Gen 6 and Beyond",
+	SlotTypeEDSFFE1:                            "Enterprise and Datacenter 1U E1 Form Factor Slot (EDSFF E1.S, E1.L)",
+	SlotTypeEDSFFE3:                            "Enterprise and Datacenter 3\" E3 Form Factor Slot (EDSFF E3.S, E3.L)",
+}
+
+// SlotWidth represents the slot width.
+type SlotWidth int
+
+const (
+	// SlotWidthOther is a slot width.
+	SlotWidthOther SlotWidth = iota + 1
+	// SlotWidthUnknown is a slot width.
+	SlotWidthUnknown
+	// SlotWidth8Bit is a slot width.
+	SlotWidth8Bit
+	// SlotWidth16Bit is a slot width.
+	SlotWidth16Bit
+	// SlotWidth32Bit is a slot width.
+	SlotWidth32Bit
+	// SlotWidth64Bit is a slot width.
+	SlotWidth64Bit
+	// SlotWidth128Bit is a slot width.
+	SlotWidth128Bit
+	// SlotWidthX1 is a slot width.
+	SlotWidthX1
+	// SlotWidthX2 is a slot width.
+	SlotWidthX2
+	// SlotWidthX4 is a slot width.
+	SlotWidthX4
+	// SlotWidthX8 is a slot width.
+	SlotWidthX8
+	// SlotWidthX12 is a slot width.
+	SlotWidthX12
+	// SlotWidthX16 is a slot width.
+	SlotWidthX16
+	// SlotWidthX32 is a slot width.
+	SlotWidthX32
+)
+
+// String returns the string representation of a `SlotWidth`.
+//
+//nolint:gocyclo,cyclop
+func (s SlotWidth) String() string {
+	switch s {
+	case SlotWidthOther:
+		return _Other
+	case SlotWidthUnknown:
+		return _Unknown
+	case SlotWidth8Bit:
+		return "8 bit"
+	case SlotWidth16Bit:
+		return "16 bit"
+	case SlotWidth32Bit:
+		return "32 bit"
+	case SlotWidth64Bit:
+		return "64 bit"
+	case SlotWidth128Bit:
+		return "128 bit"
+	case SlotWidthX1:
+		return "x1"
+	case SlotWidthX2:
+		return "x2"
+	case SlotWidthX4:
+		return "x4"
+	case SlotWidthX8:
+		return "x8"
+	case SlotWidthX12:
+		return "x12"
+	case SlotWidthX16:
+		return "x16"
+	case SlotWidthX32:
+		return "x32"
+	}
+
+	return _Unknown
+}
+
+// Lanes returns the number of lanes for lane-based widths, or 0 otherwise.
+func (s SlotWidth) Lanes() int {
+	switch s { //nolint:exhaustive
+	case SlotWidthX1:
+		return 1
+	case SlotWidthX2:
+		return 2
+	case SlotWidthX4:
+		return 4
+	case SlotWidthX8:
+		return 8
+	case SlotWidthX12:
+		return 12
+	case SlotWidthX16:
+		return 16
+	case SlotWidthX32:
+		return 32
+	}
+
+	return 0
+}
+
+// SlotUsage represents the slot current usage.
+type SlotUsage int
+
+const (
+	// SlotUsageOther is a slot usage.
+	SlotUsageOther SlotUsage = iota + 1
+	// SlotUsageUnknown is a slot usage.
+	SlotUsageUnknown
+	// SlotUsageAvailable is a slot usage.
+	SlotUsageAvailable
+	// SlotUsageInUse is a slot usage.
+	SlotUsageInUse
+	// SlotUsageUnavailable is a slot usage.
+	SlotUsageUnavailable
+)
+
+// String returns the string representation of a `SlotUsage`.
+func (s SlotUsage) String() string {
+	switch s {
+	case SlotUsageOther:
+		return _Other
+	case SlotUsageUnknown:
+		return _Unknown
+	case SlotUsageAvailable:
+		return "Available"
+	case SlotUsageInUse:
+		return "In use"
+	case SlotUsageUnavailable:
+		return "Unavailable"
+	}
+
+	return _Unknown
+}
+
+// SlotLength represents the slot length.
+type SlotLength int
+
+const (
+	// SlotLengthOther is a slot length.
+	SlotLengthOther SlotLength = iota + 1
+	// SlotLengthUnknown is a slot length.
+	SlotLengthUnknown
+	// SlotLengthShort is a slot length.
+	SlotLengthShort
+	// SlotLengthLong is a slot length.
+	SlotLengthLong
+	// SlotLength25InchDrive is a slot length.
+	SlotLength25InchDrive
+	// SlotLength35InchDrive is a slot length.
+	SlotLength35InchDrive
+)
+
+// String returns the string representation of a `SlotLength`.
+func (s SlotLength) String() string {
+	switch s {
+	case SlotLengthOther:
+		return _Other
+	case SlotLengthUnknown:
+		return _Unknown
+	case SlotLengthShort:
+		return "Short Length"
+	case SlotLengthLong:
+		return "Long Length"
+	case SlotLength25InchDrive:
+		return "2.5\" drive form factor"
+	case SlotLength35InchDrive:
+		return "3.5\" drive form factor"
+	}
+
+	return _Unknown
+}
+
+// SlotCharacteristics1 represents the slot characteristics.
+type SlotCharacteristics1 uint8
+
+// slotCharacteristics1Names maps slot characteristics 1 bits to their names.
+var slotCharacteristics1Names = []string{
+	"Characteristics unknown",
+	"Provides 5.0 volts",
+	"Provides 3.3 volts",
+	"Slot's opening is shared with another slot",
+	"PC Card slot supports PC Card-16",
+	"PC Card slot supports CardBus",
+	"PC Card slot supports Zoom Video",
+	"PC Card slot supports Modem Ring Resume",
+}
+
+// Characteristics returns the names of the characteristics that are set.
+func (s SlotCharacteristics1) Characteristics() []string {
+	return _GetBitNames(int(s), slotCharacteristics1Names)
+}
+
+// String returns the string representation of a `SlotCharacteristics1`.
+func (s SlotCharacteristics1) String() string {
+	return strings.Join(s.Characteristics(), ", ")
+}
+
+// SlotCharacteristics2 represents additional slot characteristics.
+type SlotCharacteristics2 uint8
+
+// slotCharacteristics2Names maps slot characteristics 2 bits to their names.
+var slotCharacteristics2Names = []string{
+	"PCI slot supports Power Management Event (PME#) signal",
+	"Slot supports hot-plug devices",
+	"PCI slot supports SMBus signal",
+	"PCIe slot supports bifurcation",
+	"Slot supports async/surprise removal",
+	"Flexbus slot, CXL 1.0 capable",
+	"Flexbus slot, CXL 2.0 capable",
+	"Flexbus slot, CXL 3.0 capable",
+}
+
+// SupportsBifurcation returns true if the PCIe slot supports bifurcation.
+func (s SlotCharacteristics2) SupportsBifurcation() bool {
+	return IsNthBitSet(int(s), 3)
+}
+
+// SupportsHotPlug returns true if the slot supports hot-plug devices.
+func (s SlotCharacteristics2) SupportsHotPlug() bool {
+	return IsNthBitSet(int(s), 1)
+}
+
+// Characteristics returns the names of the characteristics that are set.
+func (s SlotCharacteristics2) Characteristics() []string {
+	return _GetBitNames(int(s), slotCharacteristics2Names)
+}
+
+// String returns the string representation of a `SlotCharacteristics2`.
+func (s SlotCharacteristics2) String() string {
+	return strings.Join(s.Characteristics(), ", ")
+}
+
+// SlotHeight represents the maximum supported card height for a slot.
+type SlotHeight int
+
+const (
+	// SlotHeightNotApplicable is a slot height.
+	SlotHeightNotApplicable SlotHeight = iota
+	// SlotHeightOther is a slot height.
+	SlotHeightOther
+	// SlotHeightUnknown is a slot height.
+	SlotHeightUnknown
+	// SlotHeightFullHeight is a slot height.
+	SlotHeightFullHeight
+	// SlotHeightLowProfile is a slot height.
+	SlotHeightLowProfile
+)
+
+// String returns the string representation of a `SlotHeight`.
+func (s SlotHeight) String() string {
+	switch s {
+	case SlotHeightNotApplicable:
+		return "Not applicable"
+	case SlotHeightOther:
+		return _Other
+	case SlotHeightUnknown:
+		return _Unknown
+	case SlotHeightFullHeight:
+		return "Full height"
+	case SlotHeightLowProfile:
+		return "Low-profile"
 	}
+
+	return _Unknown
+}
+
+// FreePCIeSlots returns the PCI Express slots that are available for use.
+func (s *SMBIOS) FreePCIeSlots() []SystemSlot {
+	var slots []SystemSlot
+
+	for _, slot := range s.SystemSlots {
+		if slot.SlotType.IsPCIe() && slot.CurrentUsage == SlotUsageAvailable {
+			slots = append(slots, slot)
+		}
+	}
+
+	return slots
 }
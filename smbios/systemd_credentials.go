@@ -0,0 +1,167 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smbios
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	systemdCredentialPrefix       = "io.systemd.credential:"
+	systemdBinaryCredentialPrefix = "io.systemd.credential.binary:"
+	systemdKernelCmdlineExtra     = "io.systemd.stub.kernel-cmdline-extra="
+)
+
+// SystemdCredential represents a credential passed to systemd through an OEM string.
+type SystemdCredential struct {
+	// Name returns the name of the credential.
+	Name string
+	// Value returns the value of the credential, base64 decoded for binary credentials.
+	Value []byte
+	// Binary returns true if the credential was passed base64 encoded.
+	Binary bool
+}
+
+// SystemdCredentials represents the systemd data found in OEM strings.
+// See https://systemd.io/CREDENTIALS/ and smbios-type-11(7).
+type SystemdCredentials struct {
+	// Credentials returns the credentials, in table order.
+	Credentials []SystemdCredential
+	// KernelCommandLineExtra returns the extra kernel command line arguments, in table order.
+	KernelCommandLineExtra []string
+	// Duplicates returns the names of the credentials that are defined more than once.
+	// As with systemd, only the first definition is kept.
+	Duplicates []string
+}
+
+// Lookup returns the credential with the given name.
+func (c SystemdCredentials) Lookup(name string) (SystemdCredential, bool) {
+	for _, credential := range c.Credentials {
+		if credential.Name == name {
+			return credential, true
+		}
+	}
+
+	return SystemdCredential{}, false
+}
+
+// SystemdCredentials returns the systemd credentials and kernel command line
+// extras found in all OEM strings structures.
+// Invalid entries are skipped and reported in the returned error,
+// which does not prevent the valid entries from being returned.
+func (s *SMBIOS) SystemdCredentials() (SystemdCredentials, error) {
+	var strs []string
+
+	for _, o := range s.OEMStrings {
+		strs = append(strs, o.Strings...)
+	}
+
+	return ParseSystemdCredentials(strs)
+}
+
+// ParseSystemdCredentials parses systemd credentials and kernel command line
+// extras from the given OEM strings:
+//
+//	io.systemd.credential:NAME=VALUE
+//	io.systemd.credential.binary:NAME=BASE64
+//	io.systemd.stub.kernel-cmdline-extra=ARGUMENTS
+//
+// Strings in other forms are ignored. Invalid entries are skipped and reported
+// in the returned error, which does not prevent the valid entries from being returned.
+func ParseSystemdCredentials(strs []string) (SystemdCredentials, error) {
+	var (
+		c    SystemdCredentials
+		errs []error
+	)
+
+	seen := map[string]struct{}{}
+
+	for _, s := range strs {
+		var (
+			credential string
+			binary     bool
+		)
+
+		switch {
+		case strings.HasPrefix(s, systemdKernelCmdlineExtra):
+			c.KernelCommandLineExtra = append(c.KernelCommandLineExtra, strings.TrimPrefix(s, systemdKernelCmdlineExtra))
+
+			continue
+		case strings.HasPrefix(s, systemdCredentialPrefix):
+			credential = strings.TrimPrefix(s, systemdCredentialPrefix)
+		case strings.HasPrefix(s, systemdBinaryCredentialPrefix):
+			credential, binary = strings.TrimPrefix(s, systemdBinaryCredentialPrefix), true
+		default:
+			continue
+		}
+
+		name, value, ok := strings.Cut(credential, "=")
+		if !ok {
+			errs = append(errs, fmt.Errorf("credential %q has no value", name))
+
+			continue
+		}
+
+		if !IsValidSystemdCredentialName(name) {
+			errs = append(errs, fmt.Errorf("credential name %q is invalid", name))
+
+			continue
+		}
+
+		data := []byte(value)
+
+		if binary {
+			var err error
+
+			if data, err = _DecodeBase64(value); err != nil {
+				errs = append(errs, fmt.Errorf("credential %q has an invalid base64 value: %w", name, err))
+
+				continue
+			}
+		}
+
+		if _, ok := seen[name]; ok {
+			c.Duplicates = append(c.Duplicates, name)
+
+			continue
+		}
+
+		seen[name] = struct{}{}
+
+		c.Credentials = append(c.Credentials, SystemdCredential{
+			Name:   name,
+			Value:  data,
+			Binary: binary,
+		})
+	}
+
+	return c, errors.Join(errs...)
+}
+
+// IsValidSystemdCredentialName returns true if the name is a valid systemd credential name:
+// a valid file name of at most 255 printable ASCII characters without `/` and `:`.
+func IsValidSystemdCredentialName(name string) bool {
+	if name == "" || name == "." || name == ".." || len(name) > 255 {
+		return false
+	}
+
+	for _, c := range []byte(name) {
+		if c < ' ' || c >= 0x7F || c == '/' || c == ':' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// _DecodeBase64 decodes a base64 value, ignoring whitespace and missing padding.
+func _DecodeBase64(s string) ([]byte, error) {
+	s = strings.Join(strings.Fields(s), "")
+
+	return base64.RawStdEncoding.DecodeString(strings.TrimRight(s, "="))
+}
@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smbios
+
+// structureTypeVersions maps structure types to the specification version that introduced them,
+// for the types introduced after SMBIOS 2.3.
+var structureTypeVersions = map[uint8]Version{
+	40: {Major: 2, Minor: 6},
+	41: {Major: 2, Minor: 6},
+	42: {Major: 3, Minor: 0},
+	43: {Major: 3, Minor: 1},
+	44: {Major: 3, Minor: 3},
+	45: {Major: 3, Minor: 5},
+	46: {Major: 3, Minor: 5},
+}
+
+// InferVersion returns a best guess of the specification version of a table
+// without entry point: the latest version that introduced one of the
+// structure types or fields present in the given structures, or SMBIOS 2.0.
+// Firmware may implement a later version without using any field it introduced,
+// so the result is a lower bound.
+func InferVersion(structures []*Structure) Version {
+	version := Version{Major: 2, Minor: 0}
+
+	for _, structure := range structures {
+		if v, ok := structureTypeVersions[structure.Header.Type]; ok && version._Less(v) {
+			version = v
+		}
+
+		for _, f := range structureFields[structure.Header.Type] {
+			if structure.HasField(f) && version._Less(f.Since) {
+				version = f.Since
+			}
+		}
+	}
+
+	return version
+}
+
+// _Less returns true if the version is older than the given version.
+func (v Version) _Less(o Version) bool {
+	if v.Major != o.Major {
+		return v.Major < o.Major
+	}
+
+	if v.Minor != o.Minor {
+		return v.Minor < o.Minor
+	}
+
+	return v.Revision < o.Revision
+}